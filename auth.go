@@ -1,106 +1,117 @@
 package vrchat
 
 import (
+	"context"
 	"fmt"
+
+	"github.com/go-resty/resty/v2"
 )
 
 // Authenticate authenticates the client with the VRChat API using the username and password.
+//
+// Deprecated: use Login and CompleteLogin, which return typed results.
 func (c *Client) Authenticate(username, password string) (string, error) {
-	c.client.SetHeaders(map[string]string{
-		"Accept":       "application/json",
-		"Content-Type": "application/json",
-	})
-	resp, err := c.client.R().
-		SetBasicAuth(username, password).
-		Get("/auth/user")
+	return c.AuthenticateWithContext(context.Background(), username, password)
+}
+
+// AuthenticateWithContext is like Authenticate but sends the request with ctx.
+//
+// Deprecated: use LoginWithContext and CompleteLoginWithContext.
+func (c *Client) AuthenticateWithContext(ctx context.Context, username, password string) (string, error) {
+	req := c.newRequest(ctx, "GetCurrentUser", "/auth/user").
+		SetBasicAuth(username, password)
+	resp, err := c.send(req, resty.MethodGet, "/auth/user")
 	if err != nil {
-		return "", err
+		return "", fmt.Errorf("error sending request: %w", err)
 	}
 
 	if resp.StatusCode() != 200 {
-		return "", fmt.Errorf("failed to authenticate: %s", resp.String())
+		return "", newAPIError("GetCurrentUser", resp)
 	}
 
-	cookies := resp.Cookies()
-	c.client.SetCookies(cookies)
-
 	return resp.String(), nil
 }
 
 // VerifyRecoveryOTP authenticates the client with the VRChat API using the email recovery OTP code.
+//
+// Deprecated: use Login and CompleteLogin, which return typed results.
 func (c *Client) VerifyRecoveryOTP(username, password, totp string) (string, error) {
-	c.client.SetHeaders(map[string]string{
-		"Accept":       "application/json",
-		"Content-Type": "application/json",
-	})
-	resp, err := c.client.R().
+	return c.VerifyRecoveryOTPWithContext(context.Background(), username, password, totp)
+}
+
+// VerifyRecoveryOTPWithContext is like VerifyRecoveryOTP but sends the request with ctx.
+//
+// Deprecated: use LoginWithContext and CompleteLoginWithContext.
+func (c *Client) VerifyRecoveryOTPWithContext(ctx context.Context, username, password, totp string) (string, error) {
+	req := c.newRequest(ctx, "VerifyRecoveryCode", "/auth/twofactorauth/otp/verify").
 		SetBasicAuth(username, password).
 		SetBody(map[string]string{
 			"code": totp,
-		}).
-		Post("/auth/twofactorauth/otp/verify")
+		})
+	resp, err := c.send(req, resty.MethodPost, "/auth/twofactorauth/otp/verify")
 	if err != nil {
-		return "", err
+		return "", fmt.Errorf("error sending request: %w", err)
 	}
 
 	if resp.StatusCode() != 200 {
-		return "", fmt.Errorf("failed to authenticate: %s", resp.String())
+		return "", newAPIError("VerifyRecoveryCode", resp)
 	}
 
-	cookies := resp.Cookies()
-	c.client.SetCookies(cookies)
-
 	return resp.String(), nil
 }
 
 // VerifyEmailOTP authenticates the client with the VRChat API using the email OTP code.
+//
+// Deprecated: use Login and CompleteLogin, which return typed results.
 func (c *Client) VerifyEmailOTP(username, password, totp string) (string, error) {
-	c.client.SetHeaders(map[string]string{
-		"Accept":       "application/json",
-		"Content-Type": "application/json",
-	})
-	resp, err := c.client.R().
+	return c.VerifyEmailOTPWithContext(context.Background(), username, password, totp)
+}
+
+// VerifyEmailOTPWithContext is like VerifyEmailOTP but sends the request with ctx.
+//
+// Deprecated: use LoginWithContext and CompleteLoginWithContext.
+func (c *Client) VerifyEmailOTPWithContext(ctx context.Context, username, password, totp string) (string, error) {
+	req := c.newRequest(ctx, "Verify2FaEmailCode", "/auth/twofactorauth/emailotp/verify").
 		SetBasicAuth(username, password).
 		SetBody(map[string]string{
 			"code": totp,
-		}).
-		Post("/auth/twofactorauth/emailotp/verify")
+		})
+	resp, err := c.send(req, resty.MethodPost, "/auth/twofactorauth/emailotp/verify")
 	if err != nil {
-		return "", err
+		return "", fmt.Errorf("error sending request: %w", err)
 	}
 
 	if resp.StatusCode() != 200 {
-		return "", fmt.Errorf("failed to authenticate: %s", resp.String())
+		return "", newAPIError("Verify2FaEmailCode", resp)
 	}
 
-	cookies := resp.Cookies()
-	c.client.SetCookies(cookies)
-
 	return resp.String(), nil
 }
 
 // VerifyTOTP authenticates the client with the VRChat API using the TOTP code.
+//
+// Deprecated: use Login and CompleteLogin, which return typed results.
 func (c *Client) VerifyTOTP(username, password, totp string) (string, error) {
-	c.client.SetHeaders(map[string]string{
-		"Accept":       "application/json",
-		"Content-Type": "application/json",
-	})
-	resp, err := c.client.R().
+	return c.VerifyTOTPWithContext(context.Background(), username, password, totp)
+}
+
+// VerifyTOTPWithContext is like VerifyTOTP but sends the request with ctx.
+//
+// Deprecated: use LoginWithContext and CompleteLoginWithContext.
+func (c *Client) VerifyTOTPWithContext(ctx context.Context, username, password, totp string) (string, error) {
+	req := c.newRequest(ctx, "Verify2Fa", "/auth/twofactorauth/totp/verify").
 		SetBasicAuth(username, password).
 		SetBody(map[string]string{
 			"code": totp,
-		}).
-		Post("/auth/twofactorauth/totp/verify")
+		})
+	resp, err := c.send(req, resty.MethodPost, "/auth/twofactorauth/totp/verify")
 	if err != nil {
-		return "", err
+		return "", fmt.Errorf("error sending request: %w", err)
 	}
 
 	if resp.StatusCode() != 200 {
-		return "", fmt.Errorf("failed to authenticate: %s", resp.String())
+		return "", newAPIError("Verify2Fa", resp)
 	}
 
-	cookies := resp.Cookies()
-	c.client.SetCookies(cookies)
-
 	return resp.String(), nil
 }
@@ -1,7 +1,9 @@
 package vrchat
 
 import (
+	"context"
 	"fmt"
+	"net/url"
 	"strings"
 	"time"
 
@@ -9,16 +11,6 @@ import (
 	"github.com/samber/lo"
 )
 
-type Client struct {
-	client *resty.Client
-}
-
-func NewClient(baseURL string, UserAgent string) *Client {
-	return &Client{
-		client: resty.New().SetBaseURL(baseURL).SetHeader("User-Agent", UserAgent),
-	}
-}
-
 // CheckUserExistsParams represents the parameters for the CheckUserExists request
 type CheckUserExistsParams struct {
 	Email         string `json:"email"`
@@ -28,6 +20,10 @@ type CheckUserExistsParams struct {
 }
 
 func (c *Client) CheckUserExists(params CheckUserExistsParams) (*UserExistsResponse, error) {
+	return c.CheckUserExistsWithContext(context.Background(), params)
+}
+
+func (c *Client) CheckUserExistsWithContext(ctx context.Context, params CheckUserExistsParams) (*UserExistsResponse, error) {
 	path := "/auth/exists"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -45,7 +41,7 @@ func (c *Client) CheckUserExists(params CheckUserExistsParams) (*UserExistsRespo
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "CheckUserExists", "/auth/exists")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -53,67 +49,79 @@ func (c *Client) CheckUserExists(params CheckUserExistsParams) (*UserExistsRespo
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("CheckUserExists", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) GetCurrentUser() (*CurrentUserLoginResponse, error) {
+	return c.GetCurrentUserWithContext(context.Background())
+}
+
+func (c *Client) GetCurrentUserWithContext(ctx context.Context) (*CurrentUserLoginResponse, error) {
 	path := "/auth/user"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetCurrentUser", "/auth/user")
 	// Set response object
 	var result CurrentUserLoginResponse
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetCurrentUser", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) Disable2Fa() (*Disable2FaResponse, error) {
+	return c.Disable2FaWithContext(context.Background())
+}
+
+func (c *Client) Disable2FaWithContext(ctx context.Context) (*Disable2FaResponse, error) {
 	path := "/auth/twofactorauth"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "Disable2Fa", "/auth/twofactorauth")
 	// Set response object
 	var result Disable2FaResponse
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Delete(path)
+	resp, err := c.send(req, resty.MethodDelete, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("Disable2Fa", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) Verify2Fa(body TwoFactorAuthCode) (*Verify2FaResponse, error) {
+	return c.Verify2FaWithContext(context.Background(), body)
+}
+
+func (c *Client) Verify2FaWithContext(ctx context.Context, body TwoFactorAuthCode) (*Verify2FaResponse, error) {
 	path := "/auth/twofactorauth/totp/verify"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "Verify2Fa", "/auth/twofactorauth/totp/verify")
 	// Set request body
 	req.SetBody(body)
 	// Set response object
@@ -121,67 +129,79 @@ func (c *Client) Verify2Fa(body TwoFactorAuthCode) (*Verify2FaResponse, error) {
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodPost, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("Verify2Fa", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) Enable2Fa() (*Pending2FaResponse, error) {
+	return c.Enable2FaWithContext(context.Background())
+}
+
+func (c *Client) Enable2FaWithContext(ctx context.Context) (*Pending2FaResponse, error) {
 	path := "/auth/twofactorauth/totp/pending"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "Enable2Fa", "/auth/twofactorauth/totp/pending")
 	// Set response object
 	var result Pending2FaResponse
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodPost, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("Enable2Fa", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) CancelPending2Fa() (*Disable2FaResponse, error) {
+	return c.CancelPending2FaWithContext(context.Background())
+}
+
+func (c *Client) CancelPending2FaWithContext(ctx context.Context) (*Disable2FaResponse, error) {
 	path := "/auth/twofactorauth/totp/pending"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "CancelPending2Fa", "/auth/twofactorauth/totp/pending")
 	// Set response object
 	var result Disable2FaResponse
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Delete(path)
+	resp, err := c.send(req, resty.MethodDelete, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("CancelPending2Fa", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) VerifyPending2Fa(body TwoFactorAuthCode) (*Verify2FaResponse, error) {
+	return c.VerifyPending2FaWithContext(context.Background(), body)
+}
+
+func (c *Client) VerifyPending2FaWithContext(ctx context.Context, body TwoFactorAuthCode) (*Verify2FaResponse, error) {
 	path := "/auth/twofactorauth/totp/pending/verify"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "VerifyPending2Fa", "/auth/twofactorauth/totp/pending/verify")
 	// Set request body
 	req.SetBody(body)
 	// Set response object
@@ -189,45 +209,53 @@ func (c *Client) VerifyPending2Fa(body TwoFactorAuthCode) (*Verify2FaResponse, e
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodPost, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("VerifyPending2Fa", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) GetRecoveryCodes() (*Get2FaRecoveryCodesResponse, error) {
+	return c.GetRecoveryCodesWithContext(context.Background())
+}
+
+func (c *Client) GetRecoveryCodesWithContext(ctx context.Context) (*Get2FaRecoveryCodesResponse, error) {
 	path := "/auth/user/twofactorauth/otp"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetRecoveryCodes", "/auth/user/twofactorauth/otp")
 	// Set response object
 	var result Get2FaRecoveryCodesResponse
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetRecoveryCodes", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) VerifyRecoveryCode(body TwoFactorAuthCode) (*Verify2FaResponse, error) {
+	return c.VerifyRecoveryCodeWithContext(context.Background(), body)
+}
+
+func (c *Client) VerifyRecoveryCodeWithContext(ctx context.Context, body TwoFactorAuthCode) (*Verify2FaResponse, error) {
 	path := "/auth/twofactorauth/otp/verify"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "VerifyRecoveryCode", "/auth/twofactorauth/otp/verify")
 	// Set request body
 	req.SetBody(body)
 	// Set response object
@@ -235,23 +263,27 @@ func (c *Client) VerifyRecoveryCode(body TwoFactorAuthCode) (*Verify2FaResponse,
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodPost, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("VerifyRecoveryCode", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) Verify2FaEmailCode(body TwoFactorEmailCode) (*Verify2FaEmailCodeResponse, error) {
+	return c.Verify2FaEmailCodeWithContext(context.Background(), body)
+}
+
+func (c *Client) Verify2FaEmailCodeWithContext(ctx context.Context, body TwoFactorEmailCode) (*Verify2FaEmailCodeResponse, error) {
 	path := "/auth/twofactorauth/emailotp/verify"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "Verify2FaEmailCode", "/auth/twofactorauth/emailotp/verify")
 	// Set request body
 	req.SetBody(body)
 	// Set response object
@@ -259,58 +291,66 @@ func (c *Client) Verify2FaEmailCode(body TwoFactorEmailCode) (*Verify2FaEmailCod
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodPost, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("Verify2FaEmailCode", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) VerifyAuthToken() (*VerifyAuthTokenResponse, error) {
+	return c.VerifyAuthTokenWithContext(context.Background())
+}
+
+func (c *Client) VerifyAuthTokenWithContext(ctx context.Context) (*VerifyAuthTokenResponse, error) {
 	path := "/auth"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "VerifyAuthToken", "/auth")
 	// Set response object
 	var result VerifyAuthTokenResponse
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("VerifyAuthToken", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) Logout() (*LogoutSuccess, error) {
+	return c.LogoutWithContext(context.Background())
+}
+
+func (c *Client) LogoutWithContext(ctx context.Context) (*LogoutSuccess, error) {
 	path := "/logout"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "Logout", "/logout")
 	// Set response object
 	var result LogoutSuccess
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Put(path)
+	resp, err := c.send(req, resty.MethodPut, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("Logout", resp)
 	}
 	return &result, nil
 }
@@ -321,13 +361,17 @@ type DeleteUserParams struct { // UserId A users unique ID, usually in the form
 }
 
 func (c *Client) DeleteUser(params DeleteUserParams) (*DeleteUserResponse, error) {
+	return c.DeleteUserWithContext(context.Background(), params)
+}
+
+func (c *Client) DeleteUserWithContext(ctx context.Context, params DeleteUserParams) (*DeleteUserResponse, error) {
 	path := "/users/{userId}/delete"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "DeleteUser", "/users/{userId}/delete")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -335,23 +379,27 @@ func (c *Client) DeleteUser(params DeleteUserParams) (*DeleteUserResponse, error
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Put(path)
+	resp, err := c.send(req, resty.MethodPut, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("DeleteUser", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) RegisterUserAccount(body RegisterUserAccountRequest) (*CurrentUserLoginResponse, error) {
+	return c.RegisterUserAccountWithContext(context.Background(), body)
+}
+
+func (c *Client) RegisterUserAccountWithContext(ctx context.Context, body RegisterUserAccountRequest) (*CurrentUserLoginResponse, error) {
 	path := "/auth/register"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "RegisterUserAccount", "/auth/register")
 	// Set request body
 	req.SetBody(body)
 	// Set response object
@@ -359,36 +407,40 @@ func (c *Client) RegisterUserAccount(body RegisterUserAccountRequest) (*CurrentU
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodPost, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("RegisterUserAccount", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) ResendEmailConfirmation() (*ResendVerificationEmailSuccess, error) {
+	return c.ResendEmailConfirmationWithContext(context.Background())
+}
+
+func (c *Client) ResendEmailConfirmationWithContext(ctx context.Context) (*ResendVerificationEmailSuccess, error) {
 	path := "/auth/user/resendEmail"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "ResendEmailConfirmation", "/auth/user/resendEmail")
 	// Set response object
 	var result ResendVerificationEmailSuccess
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodPost, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("ResendEmailConfirmation", resp)
 	}
 	return &result, nil
 }
@@ -400,6 +452,10 @@ type ConfirmEmailParams struct { // Id A users unique ID, usually in the form of
 }
 
 func (c *Client) ConfirmEmail(params ConfirmEmailParams) error {
+	return c.ConfirmEmailWithContext(context.Background(), params)
+}
+
+func (c *Client) ConfirmEmailWithContext(ctx context.Context, params ConfirmEmailParams) error {
 	path := "/auth/confirmEmail"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -411,19 +467,19 @@ func (c *Client) ConfirmEmail(params ConfirmEmailParams) error {
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "ConfirmEmail", "/auth/confirmEmail")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return newAPIError("ConfirmEmail", resp)
 	}
 	return nil
 }
@@ -435,6 +491,10 @@ type VerifyLoginPlaceParams struct { // UserId A users unique ID, usually in the
 }
 
 func (c *Client) VerifyLoginPlace(params VerifyLoginPlaceParams) error {
+	return c.VerifyLoginPlaceWithContext(context.Background(), params)
+}
+
+func (c *Client) VerifyLoginPlaceWithContext(ctx context.Context, params VerifyLoginPlaceParams) error {
 	path := "/auth/verifyLoginPlace"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -446,41 +506,45 @@ func (c *Client) VerifyLoginPlace(params VerifyLoginPlaceParams) error {
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "VerifyLoginPlace", "/auth/verifyLoginPlace")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return newAPIError("VerifyLoginPlace", resp)
 	}
 	return nil
 }
 
 func (c *Client) GetGlobalAvatarModerations() (*GetAvatarModerationsResponse, error) {
+	return c.GetGlobalAvatarModerationsWithContext(context.Background())
+}
+
+func (c *Client) GetGlobalAvatarModerationsWithContext(ctx context.Context) (*GetAvatarModerationsResponse, error) {
 	path := "/auth/user/avatarmoderations"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetGlobalAvatarModerations", "/auth/user/avatarmoderations")
 	// Set response object
 	var result GetAvatarModerationsResponse
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetGlobalAvatarModerations", resp)
 	}
 	return &result, nil
 }
@@ -491,13 +555,17 @@ type GetOwnAvatarParams struct { // UserId A users unique ID, usually in the for
 }
 
 func (c *Client) GetOwnAvatar(params GetOwnAvatarParams) (*AvatarResponse, error) {
+	return c.GetOwnAvatarWithContext(context.Background(), params)
+}
+
+func (c *Client) GetOwnAvatarWithContext(ctx context.Context, params GetOwnAvatarParams) (*AvatarResponse, error) {
 	path := "/users/{userId}/avatar"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetOwnAvatar", "/users/{userId}/avatar")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -505,23 +573,27 @@ func (c *Client) GetOwnAvatar(params GetOwnAvatarParams) (*AvatarResponse, error
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetOwnAvatar", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) CreateAvatar(body CreateAvatarRequest) (*AvatarResponse, error) {
+	return c.CreateAvatarWithContext(context.Background(), body)
+}
+
+func (c *Client) CreateAvatarWithContext(ctx context.Context, body CreateAvatarRequest) (*AvatarResponse, error) {
 	path := "/avatars"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "CreateAvatar", "/avatars")
 	// Set request body
 	req.SetBody(body)
 	// Set response object
@@ -529,40 +601,44 @@ func (c *Client) CreateAvatar(body CreateAvatarRequest) (*AvatarResponse, error)
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodPost, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("CreateAvatar", resp)
 	}
 	return &result, nil
 }
 
 // SearchAvatarsParams represents the parameters for the SearchAvatars request
 type SearchAvatarsParams struct {
-	Featured        bool          `json:"featured"`
-	Sort            SortOption    `json:"sort"` // UserId A users unique ID, usually in the form of `usr_c1644b5b-3ca4-45b4-97c6-a2a0de70d469`. Legacy players can have old IDs in the form of `8JoV9XEdpo`. The ID can never be changed.
-	UserId          UserId        `json:"userId"`
-	N               int64         `json:"n"`
-	Order           OrderOption   `json:"order"`
-	Offset          int64         `json:"offset"`
-	Tag             string        `json:"tag"`
-	Notag           string        `json:"notag"`
-	ReleaseStatus   ReleaseStatus `json:"releaseStatus"`
-	MaxUnityVersion string        `json:"maxUnityVersion"`
-	MinUnityVersion string        `json:"minUnityVersion"`
-	Platform        string        `json:"platform"`
+	Featured        Optional[bool]  `json:"featured"`
+	Sort            SortOption      `json:"sort"` // UserId A users unique ID, usually in the form of `usr_c1644b5b-3ca4-45b4-97c6-a2a0de70d469`. Legacy players can have old IDs in the form of `8JoV9XEdpo`. The ID can never be changed.
+	UserId          UserId          `json:"userId"`
+	N               Optional[int64] `json:"n"`
+	Order           OrderOption     `json:"order"`
+	Offset          Optional[int64] `json:"offset"`
+	Tag             string          `json:"tag"`
+	Notag           string          `json:"notag"`
+	ReleaseStatus   ReleaseStatus   `json:"releaseStatus"`
+	MaxUnityVersion string          `json:"maxUnityVersion"`
+	MinUnityVersion string          `json:"minUnityVersion"`
+	Platform        string          `json:"platform"`
 }
 
 func (c *Client) SearchAvatars(params SearchAvatarsParams) (*AvatarListResponse, error) {
+	return c.SearchAvatarsWithContext(context.Background(), params)
+}
+
+func (c *Client) SearchAvatarsWithContext(ctx context.Context, params SearchAvatarsParams) (*AvatarListResponse, error) {
 	path := "/avatars"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	if lo.IsNotEmpty(params.Featured) {
-		queryParams["featured"] = fmt.Sprintf("%v", params.Featured)
+	if v, ok := params.Featured.Get(); ok {
+		queryParams["featured"] = fmt.Sprintf("%v", v)
 	}
 	if lo.IsNotEmpty(params.Sort) {
 		queryParams["sort"] = fmt.Sprintf("%v", params.Sort)
@@ -570,14 +646,14 @@ func (c *Client) SearchAvatars(params SearchAvatarsParams) (*AvatarListResponse,
 	if lo.IsNotEmpty(params.UserId) {
 		queryParams["userId"] = fmt.Sprintf("%v", params.UserId)
 	}
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if v, ok := params.N.Get(); ok {
+		queryParams["n"] = fmt.Sprintf("%v", v)
 	}
 	if lo.IsNotEmpty(params.Order) {
 		queryParams["order"] = fmt.Sprintf("%v", params.Order)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if v, ok := params.Offset.Get(); ok {
+		queryParams["offset"] = fmt.Sprintf("%v", v)
 	}
 	if lo.IsNotEmpty(params.Tag) {
 		queryParams["tag"] = fmt.Sprintf("%v", params.Tag)
@@ -599,7 +675,7 @@ func (c *Client) SearchAvatars(params SearchAvatarsParams) (*AvatarListResponse,
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "SearchAvatars", "/avatars")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -607,36 +683,40 @@ func (c *Client) SearchAvatars(params SearchAvatarsParams) (*AvatarListResponse,
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("SearchAvatars", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) GetAvatarStyles() (*AvatarStyleListResponse, error) {
+	return c.GetAvatarStylesWithContext(context.Background())
+}
+
+func (c *Client) GetAvatarStylesWithContext(ctx context.Context) (*AvatarStyleListResponse, error) {
 	path := "/avatarStyles"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetAvatarStyles", "/avatarStyles")
 	// Set response object
 	var result AvatarStyleListResponse
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetAvatarStyles", resp)
 	}
 	return &result, nil
 }
@@ -647,13 +727,17 @@ type UpdateAvatarParams struct {
 }
 
 func (c *Client) UpdateAvatar(params UpdateAvatarParams, body UpdateAvatarRequest) (*AvatarResponse, error) {
+	return c.UpdateAvatarWithContext(context.Background(), params, body)
+}
+
+func (c *Client) UpdateAvatarWithContext(ctx context.Context, params UpdateAvatarParams, body UpdateAvatarRequest) (*AvatarResponse, error) {
 	path := "/avatars/{avatarId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{avatarId}", fmt.Sprintf("%v", params.AvatarId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "UpdateAvatar", "/avatars/{avatarId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set request body
@@ -663,14 +747,14 @@ func (c *Client) UpdateAvatar(params UpdateAvatarParams, body UpdateAvatarReques
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Put(path)
+	resp, err := c.send(req, resty.MethodPut, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("UpdateAvatar", resp)
 	}
 	return &result, nil
 }
@@ -681,13 +765,17 @@ type DeleteAvatarParams struct {
 }
 
 func (c *Client) DeleteAvatar(params DeleteAvatarParams) (*AvatarResponse, error) {
+	return c.DeleteAvatarWithContext(context.Background(), params)
+}
+
+func (c *Client) DeleteAvatarWithContext(ctx context.Context, params DeleteAvatarParams) (*AvatarResponse, error) {
 	path := "/avatars/{avatarId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{avatarId}", fmt.Sprintf("%v", params.AvatarId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "DeleteAvatar", "/avatars/{avatarId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -695,14 +783,14 @@ func (c *Client) DeleteAvatar(params DeleteAvatarParams) (*AvatarResponse, error
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Delete(path)
+	resp, err := c.send(req, resty.MethodDelete, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("DeleteAvatar", resp)
 	}
 	return &result, nil
 }
@@ -713,13 +801,17 @@ type GetAvatarParams struct {
 }
 
 func (c *Client) GetAvatar(params GetAvatarParams) (*AvatarResponse, error) {
+	return c.GetAvatarWithContext(context.Background(), params)
+}
+
+func (c *Client) GetAvatarWithContext(ctx context.Context, params GetAvatarParams) (*AvatarResponse, error) {
 	path := "/avatars/{avatarId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{avatarId}", fmt.Sprintf("%v", params.AvatarId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetAvatar", "/avatars/{avatarId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -727,14 +819,14 @@ func (c *Client) GetAvatar(params GetAvatarParams) (*AvatarResponse, error) {
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetAvatar", resp)
 	}
 	return &result, nil
 }
@@ -745,13 +837,17 @@ type SelectAvatarParams struct {
 }
 
 func (c *Client) SelectAvatar(params SelectAvatarParams) (*CurrentUserResponse, error) {
+	return c.SelectAvatarWithContext(context.Background(), params)
+}
+
+func (c *Client) SelectAvatarWithContext(ctx context.Context, params SelectAvatarParams) (*CurrentUserResponse, error) {
 	path := "/avatars/{avatarId}/select"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{avatarId}", fmt.Sprintf("%v", params.AvatarId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "SelectAvatar", "/avatars/{avatarId}/select")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -759,14 +855,14 @@ func (c *Client) SelectAvatar(params SelectAvatarParams) (*CurrentUserResponse,
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Put(path)
+	resp, err := c.send(req, resty.MethodPut, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("SelectAvatar", resp)
 	}
 	return &result, nil
 }
@@ -777,13 +873,17 @@ type SelectFallbackAvatarParams struct {
 }
 
 func (c *Client) SelectFallbackAvatar(params SelectFallbackAvatarParams) (*CurrentUserResponse, error) {
+	return c.SelectFallbackAvatarWithContext(context.Background(), params)
+}
+
+func (c *Client) SelectFallbackAvatarWithContext(ctx context.Context, params SelectFallbackAvatarParams) (*CurrentUserResponse, error) {
 	path := "/avatars/{avatarId}/selectFallback"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{avatarId}", fmt.Sprintf("%v", params.AvatarId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "SelectFallbackAvatar", "/avatars/{avatarId}/selectFallback")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -791,53 +891,57 @@ func (c *Client) SelectFallbackAvatar(params SelectFallbackAvatarParams) (*Curre
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Put(path)
+	resp, err := c.send(req, resty.MethodPut, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("SelectFallbackAvatar", resp)
 	}
 	return &result, nil
 }
 
 // GetFavoritedAvatarsParams represents the parameters for the GetFavoritedAvatars request
 type GetFavoritedAvatarsParams struct {
-	Featured        bool          `json:"featured"`
-	Sort            SortOption    `json:"sort"`
-	N               int64         `json:"n"`
-	Order           OrderOption   `json:"order"`
-	Offset          int64         `json:"offset"`
-	Search          string        `json:"search"`
-	Tag             string        `json:"tag"`
-	Notag           string        `json:"notag"`
-	ReleaseStatus   ReleaseStatus `json:"releaseStatus"`
-	MaxUnityVersion string        `json:"maxUnityVersion"`
-	MinUnityVersion string        `json:"minUnityVersion"`
-	Platform        string        `json:"platform"` // UserId A users unique ID, usually in the form of `usr_c1644b5b-3ca4-45b4-97c6-a2a0de70d469`. Legacy players can have old IDs in the form of `8JoV9XEdpo`. The ID can never be changed.
-	UserId          UserId        `json:"userId"`
+	Featured        Optional[bool]  `json:"featured"`
+	Sort            SortOption      `json:"sort"`
+	N               Optional[int64] `json:"n"`
+	Order           OrderOption     `json:"order"`
+	Offset          Optional[int64] `json:"offset"`
+	Search          string          `json:"search"`
+	Tag             string          `json:"tag"`
+	Notag           string          `json:"notag"`
+	ReleaseStatus   ReleaseStatus   `json:"releaseStatus"`
+	MaxUnityVersion string          `json:"maxUnityVersion"`
+	MinUnityVersion string          `json:"minUnityVersion"`
+	Platform        string          `json:"platform"` // UserId A users unique ID, usually in the form of `usr_c1644b5b-3ca4-45b4-97c6-a2a0de70d469`. Legacy players can have old IDs in the form of `8JoV9XEdpo`. The ID can never be changed.
+	UserId          UserId          `json:"userId"`
 }
 
 func (c *Client) GetFavoritedAvatars(params GetFavoritedAvatarsParams) (*AvatarListResponse, error) {
+	return c.GetFavoritedAvatarsWithContext(context.Background(), params)
+}
+
+func (c *Client) GetFavoritedAvatarsWithContext(ctx context.Context, params GetFavoritedAvatarsParams) (*AvatarListResponse, error) {
 	path := "/avatars/favorites"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	if lo.IsNotEmpty(params.Featured) {
-		queryParams["featured"] = fmt.Sprintf("%v", params.Featured)
+	if v, ok := params.Featured.Get(); ok {
+		queryParams["featured"] = fmt.Sprintf("%v", v)
 	}
 	if lo.IsNotEmpty(params.Sort) {
 		queryParams["sort"] = fmt.Sprintf("%v", params.Sort)
 	}
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if v, ok := params.N.Get(); ok {
+		queryParams["n"] = fmt.Sprintf("%v", v)
 	}
 	if lo.IsNotEmpty(params.Order) {
 		queryParams["order"] = fmt.Sprintf("%v", params.Order)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if v, ok := params.Offset.Get(); ok {
+		queryParams["offset"] = fmt.Sprintf("%v", v)
 	}
 	if lo.IsNotEmpty(params.Search) {
 		queryParams["search"] = fmt.Sprintf("%v", params.Search)
@@ -865,7 +969,7 @@ func (c *Client) GetFavoritedAvatars(params GetFavoritedAvatarsParams) (*AvatarL
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetFavoritedAvatars", "/avatars/favorites")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -873,37 +977,41 @@ func (c *Client) GetFavoritedAvatars(params GetFavoritedAvatarsParams) (*AvatarL
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetFavoritedAvatars", resp)
 	}
 	return &result, nil
 }
 
 // GetLicensedAvatarsParams represents the parameters for the GetLicensedAvatars request
 type GetLicensedAvatarsParams struct {
-	N      int64 `json:"n"`
-	Offset int64 `json:"offset"`
+	N      Optional[int64] `json:"n"`
+	Offset Optional[int64] `json:"offset"`
 }
 
 func (c *Client) GetLicensedAvatars(params GetLicensedAvatarsParams) (*AvatarListResponse, error) {
+	return c.GetLicensedAvatarsWithContext(context.Background(), params)
+}
+
+func (c *Client) GetLicensedAvatarsWithContext(ctx context.Context, params GetLicensedAvatarsParams) (*AvatarListResponse, error) {
 	path := "/avatars/licensed"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if v, ok := params.N.Get(); ok {
+		queryParams["n"] = fmt.Sprintf("%v", v)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if v, ok := params.Offset.Get(); ok {
+		queryParams["offset"] = fmt.Sprintf("%v", v)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetLicensedAvatars", "/avatars/licensed")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -911,14 +1019,14 @@ func (c *Client) GetLicensedAvatars(params GetLicensedAvatarsParams) (*AvatarLis
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetLicensedAvatars", resp)
 	}
 	return &result, nil
 }
@@ -929,13 +1037,17 @@ type EnqueueImpostorParams struct {
 }
 
 func (c *Client) EnqueueImpostor(params EnqueueImpostorParams) (*AvatarImpostorEnqueueResponse, error) {
+	return c.EnqueueImpostorWithContext(context.Background(), params)
+}
+
+func (c *Client) EnqueueImpostorWithContext(ctx context.Context, params EnqueueImpostorParams) (*AvatarImpostorEnqueueResponse, error) {
 	path := "/avatars/{avatarId}/impostor/enqueue"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{avatarId}", fmt.Sprintf("%v", params.AvatarId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "EnqueueImpostor", "/avatars/{avatarId}/impostor/enqueue")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -943,36 +1055,40 @@ func (c *Client) EnqueueImpostor(params EnqueueImpostorParams) (*AvatarImpostorE
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodPost, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("EnqueueImpostor", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) GetImpostorQueueStats() (*AvatarImpostorQueueStatsResponse, error) {
+	return c.GetImpostorQueueStatsWithContext(context.Background())
+}
+
+func (c *Client) GetImpostorQueueStatsWithContext(ctx context.Context) (*AvatarImpostorQueueStatsResponse, error) {
 	path := "/avatars/impostor/queue/stats"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetImpostorQueueStats", "/avatars/impostor/queue/stats")
 	// Set response object
 	var result AvatarImpostorQueueStatsResponse
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetImpostorQueueStats", resp)
 	}
 	return &result, nil
 }
@@ -983,52 +1099,60 @@ type DeleteImpostorParams struct {
 }
 
 func (c *Client) DeleteImpostor(params DeleteImpostorParams) error {
+	return c.DeleteImpostorWithContext(context.Background(), params)
+}
+
+func (c *Client) DeleteImpostorWithContext(ctx context.Context, params DeleteImpostorParams) error {
 	path := "/avatars/{avatarId}/impostor"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{avatarId}", fmt.Sprintf("%v", params.AvatarId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "DeleteImpostor", "/avatars/{avatarId}/impostor")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 
 	// Send request
-	resp, err := req.Delete(path)
+	resp, err := c.send(req, resty.MethodDelete, path)
 	if err != nil {
 		return fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return newAPIError("DeleteImpostor", resp)
 	}
 	return nil
 }
 
 // GetCalendarEventsParams represents the parameters for the GetCalendarEvents request
 type GetCalendarEventsParams struct {
-	Date   time.Time `json:"date"`
-	N      int64     `json:"n"`
-	Offset int64     `json:"offset"`
+	Date   time.Time       `json:"date"`
+	N      Optional[int64] `json:"n"`
+	Offset Optional[int64] `json:"offset"`
 }
 
 func (c *Client) GetCalendarEvents(params GetCalendarEventsParams) (*CalendarEventListResponse, error) {
+	return c.GetCalendarEventsWithContext(context.Background(), params)
+}
+
+func (c *Client) GetCalendarEventsWithContext(ctx context.Context, params GetCalendarEventsParams) (*CalendarEventListResponse, error) {
 	path := "/calendar"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	if lo.IsNotEmpty(params.Date) {
 		queryParams["date"] = fmt.Sprintf("%v", params.Date)
 	}
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if v, ok := params.N.Get(); ok {
+		queryParams["n"] = fmt.Sprintf("%v", v)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if v, ok := params.Offset.Get(); ok {
+		queryParams["offset"] = fmt.Sprintf("%v", v)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetCalendarEvents", "/calendar")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1036,41 +1160,45 @@ func (c *Client) GetCalendarEvents(params GetCalendarEventsParams) (*CalendarEve
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetCalendarEvents", resp)
 	}
 	return &result, nil
 }
 
 // GetFeaturedCalendarEventsParams represents the parameters for the GetFeaturedCalendarEvents request
 type GetFeaturedCalendarEventsParams struct {
-	Date   time.Time `json:"date"`
-	N      int64     `json:"n"`
-	Offset int64     `json:"offset"`
+	Date   time.Time       `json:"date"`
+	N      Optional[int64] `json:"n"`
+	Offset Optional[int64] `json:"offset"`
 }
 
 func (c *Client) GetFeaturedCalendarEvents(params GetFeaturedCalendarEventsParams) (*CalendarEventListResponse, error) {
+	return c.GetFeaturedCalendarEventsWithContext(context.Background(), params)
+}
+
+func (c *Client) GetFeaturedCalendarEventsWithContext(ctx context.Context, params GetFeaturedCalendarEventsParams) (*CalendarEventListResponse, error) {
 	path := "/calendar/featured"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	if lo.IsNotEmpty(params.Date) {
 		queryParams["date"] = fmt.Sprintf("%v", params.Date)
 	}
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if v, ok := params.N.Get(); ok {
+		queryParams["n"] = fmt.Sprintf("%v", v)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if v, ok := params.Offset.Get(); ok {
+		queryParams["offset"] = fmt.Sprintf("%v", v)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetFeaturedCalendarEvents", "/calendar/featured")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1078,41 +1206,45 @@ func (c *Client) GetFeaturedCalendarEvents(params GetFeaturedCalendarEventsParam
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetFeaturedCalendarEvents", resp)
 	}
 	return &result, nil
 }
 
 // GetFollowedCalendarEventsParams represents the parameters for the GetFollowedCalendarEvents request
 type GetFollowedCalendarEventsParams struct {
-	Date   time.Time `json:"date"`
-	N      int64     `json:"n"`
-	Offset int64     `json:"offset"`
+	Date   time.Time       `json:"date"`
+	N      Optional[int64] `json:"n"`
+	Offset Optional[int64] `json:"offset"`
 }
 
 func (c *Client) GetFollowedCalendarEvents(params GetFollowedCalendarEventsParams) (*CalendarEventListResponse, error) {
+	return c.GetFollowedCalendarEventsWithContext(context.Background(), params)
+}
+
+func (c *Client) GetFollowedCalendarEventsWithContext(ctx context.Context, params GetFollowedCalendarEventsParams) (*CalendarEventListResponse, error) {
 	path := "/calendar/following"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	if lo.IsNotEmpty(params.Date) {
 		queryParams["date"] = fmt.Sprintf("%v", params.Date)
 	}
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if v, ok := params.N.Get(); ok {
+		queryParams["n"] = fmt.Sprintf("%v", v)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if v, ok := params.Offset.Get(); ok {
+		queryParams["offset"] = fmt.Sprintf("%v", v)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetFollowedCalendarEvents", "/calendar/following")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1120,45 +1252,49 @@ func (c *Client) GetFollowedCalendarEvents(params GetFollowedCalendarEventsParam
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetFollowedCalendarEvents", resp)
 	}
 	return &result, nil
 }
 
 // SearchCalendarEventsParams represents the parameters for the SearchCalendarEvents request
 type SearchCalendarEventsParams struct {
-	SearchTerm string `json:"searchTerm"`
-	UtcOffset  int64  `json:"utcOffset"`
-	N          int64  `json:"n"`
-	Offset     int64  `json:"offset"`
+	SearchTerm string          `json:"searchTerm"`
+	UtcOffset  Optional[int64] `json:"utcOffset"`
+	N          Optional[int64] `json:"n"`
+	Offset     Optional[int64] `json:"offset"`
 }
 
 func (c *Client) SearchCalendarEvents(params SearchCalendarEventsParams) (*CalendarEventListResponse, error) {
+	return c.SearchCalendarEventsWithContext(context.Background(), params)
+}
+
+func (c *Client) SearchCalendarEventsWithContext(ctx context.Context, params SearchCalendarEventsParams) (*CalendarEventListResponse, error) {
 	path := "/calendar/search"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	if lo.IsNotEmpty(params.SearchTerm) {
 		queryParams["searchTerm"] = fmt.Sprintf("%v", params.SearchTerm)
 	}
-	if lo.IsNotEmpty(params.UtcOffset) {
-		queryParams["utcOffset"] = fmt.Sprintf("%v", params.UtcOffset)
+	if v, ok := params.UtcOffset.Get(); ok {
+		queryParams["utcOffset"] = fmt.Sprintf("%v", v)
 	}
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if v, ok := params.N.Get(); ok {
+		queryParams["n"] = fmt.Sprintf("%v", v)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if v, ok := params.Offset.Get(); ok {
+		queryParams["offset"] = fmt.Sprintf("%v", v)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "SearchCalendarEvents", "/calendar/search")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1166,27 +1302,31 @@ func (c *Client) SearchCalendarEvents(params SearchCalendarEventsParams) (*Calen
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("SearchCalendarEvents", resp)
 	}
 	return &result, nil
 }
 
 // GetGroupCalendarEventsParams represents the parameters for the GetGroupCalendarEvents request
 type GetGroupCalendarEventsParams struct {
-	GroupId string    `json:"groupId"`
-	Date    time.Time `json:"date"`
-	N       int64     `json:"n"`
-	Offset  int64     `json:"offset"`
+	GroupId string          `json:"groupId"`
+	Date    time.Time       `json:"date"`
+	N       Optional[int64] `json:"n"`
+	Offset  Optional[int64] `json:"offset"`
 }
 
 func (c *Client) GetGroupCalendarEvents(params GetGroupCalendarEventsParams) (*CalendarEventListResponse, error) {
+	return c.GetGroupCalendarEventsWithContext(context.Background(), params)
+}
+
+func (c *Client) GetGroupCalendarEventsWithContext(ctx context.Context, params GetGroupCalendarEventsParams) (*CalendarEventListResponse, error) {
 	path := "/calendar/{groupId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -1194,15 +1334,15 @@ func (c *Client) GetGroupCalendarEvents(params GetGroupCalendarEventsParams) (*C
 	if lo.IsNotEmpty(params.Date) {
 		queryParams["date"] = fmt.Sprintf("%v", params.Date)
 	}
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if v, ok := params.N.Get(); ok {
+		queryParams["n"] = fmt.Sprintf("%v", v)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if v, ok := params.Offset.Get(); ok {
+		queryParams["offset"] = fmt.Sprintf("%v", v)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetGroupCalendarEvents", "/calendar/{groupId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1210,14 +1350,14 @@ func (c *Client) GetGroupCalendarEvents(params GetGroupCalendarEventsParams) (*C
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetGroupCalendarEvents", resp)
 	}
 	return &result, nil
 }
@@ -1228,13 +1368,17 @@ type CreateGroupCalendarEventParams struct {
 }
 
 func (c *Client) CreateGroupCalendarEvent(params CreateGroupCalendarEventParams, body CreateCalendarEventRequest) (*CalendarEventResponse, error) {
+	return c.CreateGroupCalendarEventWithContext(context.Background(), params, body)
+}
+
+func (c *Client) CreateGroupCalendarEventWithContext(ctx context.Context, params CreateGroupCalendarEventParams, body CreateCalendarEventRequest) (*CalendarEventResponse, error) {
 	path := "/calendar/{groupId}/event"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "CreateGroupCalendarEvent", "/calendar/{groupId}/event")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set request body
@@ -1244,14 +1388,14 @@ func (c *Client) CreateGroupCalendarEvent(params CreateGroupCalendarEventParams,
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodPost, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("CreateGroupCalendarEvent", resp)
 	}
 	return &result, nil
 }
@@ -1263,6 +1407,10 @@ type DeleteGroupCalendarEventParams struct {
 }
 
 func (c *Client) DeleteGroupCalendarEvent(params DeleteGroupCalendarEventParams) (*DeleteCalendarEventSuccess, error) {
+	return c.DeleteGroupCalendarEventWithContext(context.Background(), params)
+}
+
+func (c *Client) DeleteGroupCalendarEventWithContext(ctx context.Context, params DeleteGroupCalendarEventParams) (*DeleteCalendarEventSuccess, error) {
 	path := "/calendar/{groupId}/{calendarId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -1270,7 +1418,7 @@ func (c *Client) DeleteGroupCalendarEvent(params DeleteGroupCalendarEventParams)
 	path = strings.ReplaceAll(path, "{calendarId}", fmt.Sprintf("%v", params.CalendarId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "DeleteGroupCalendarEvent", "/calendar/{groupId}/{calendarId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1278,14 +1426,14 @@ func (c *Client) DeleteGroupCalendarEvent(params DeleteGroupCalendarEventParams)
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Delete(path)
+	resp, err := c.send(req, resty.MethodDelete, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("DeleteGroupCalendarEvent", resp)
 	}
 	return &result, nil
 }
@@ -1297,6 +1445,10 @@ type GetGroupCalendarEventParams struct {
 }
 
 func (c *Client) GetGroupCalendarEvent(params GetGroupCalendarEventParams) (*CalendarEventResponse, error) {
+	return c.GetGroupCalendarEventWithContext(context.Background(), params)
+}
+
+func (c *Client) GetGroupCalendarEventWithContext(ctx context.Context, params GetGroupCalendarEventParams) (*CalendarEventResponse, error) {
 	path := "/calendar/{groupId}/{calendarId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -1304,7 +1456,7 @@ func (c *Client) GetGroupCalendarEvent(params GetGroupCalendarEventParams) (*Cal
 	path = strings.ReplaceAll(path, "{calendarId}", fmt.Sprintf("%v", params.CalendarId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetGroupCalendarEvent", "/calendar/{groupId}/{calendarId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1312,14 +1464,14 @@ func (c *Client) GetGroupCalendarEvent(params GetGroupCalendarEventParams) (*Cal
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetGroupCalendarEvent", resp)
 	}
 	return &result, nil
 }
@@ -1331,6 +1483,10 @@ type GetGroupCalendarEventIcsParams struct {
 }
 
 func (c *Client) GetGroupCalendarEventIcs(params GetGroupCalendarEventIcsParams) (*IcsResponse, error) {
+	return c.GetGroupCalendarEventIcsWithContext(context.Background(), params)
+}
+
+func (c *Client) GetGroupCalendarEventIcsWithContext(ctx context.Context, params GetGroupCalendarEventIcsParams) (*IcsResponse, error) {
 	path := "/calendar/{groupId}/{calendarId}.ics"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -1338,7 +1494,7 @@ func (c *Client) GetGroupCalendarEventIcs(params GetGroupCalendarEventIcsParams)
 	path = strings.ReplaceAll(path, "{calendarId}", fmt.Sprintf("%v", params.CalendarId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetGroupCalendarEventIcs", "/calendar/{groupId}/{calendarId}.ics")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1346,14 +1502,14 @@ func (c *Client) GetGroupCalendarEventIcs(params GetGroupCalendarEventIcsParams)
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetGroupCalendarEventIcs", resp)
 	}
 	return &result, nil
 }
@@ -1365,6 +1521,10 @@ type UpdateGroupCalendarEventParams struct {
 }
 
 func (c *Client) UpdateGroupCalendarEvent(params UpdateGroupCalendarEventParams, body UpdateCalendarEventRequest) (*CalendarEventResponse, error) {
+	return c.UpdateGroupCalendarEventWithContext(context.Background(), params, body)
+}
+
+func (c *Client) UpdateGroupCalendarEventWithContext(ctx context.Context, params UpdateGroupCalendarEventParams, body UpdateCalendarEventRequest) (*CalendarEventResponse, error) {
 	path := "/calendar/{groupId}/{calendarId}/event"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -1372,7 +1532,7 @@ func (c *Client) UpdateGroupCalendarEvent(params UpdateGroupCalendarEventParams,
 	path = strings.ReplaceAll(path, "{calendarId}", fmt.Sprintf("%v", params.CalendarId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "UpdateGroupCalendarEvent", "/calendar/{groupId}/{calendarId}/event")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set request body
@@ -1382,14 +1542,14 @@ func (c *Client) UpdateGroupCalendarEvent(params UpdateGroupCalendarEventParams,
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Put(path)
+	resp, err := c.send(req, resty.MethodPut, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("UpdateGroupCalendarEvent", resp)
 	}
 	return &result, nil
 }
@@ -1401,6 +1561,10 @@ type FollowGroupCalendarEventParams struct {
 }
 
 func (c *Client) FollowGroupCalendarEvent(params FollowGroupCalendarEventParams, body FollowCalendarEventRequest) (*CalendarEventResponse, error) {
+	return c.FollowGroupCalendarEventWithContext(context.Background(), params, body)
+}
+
+func (c *Client) FollowGroupCalendarEventWithContext(ctx context.Context, params FollowGroupCalendarEventParams, body FollowCalendarEventRequest) (*CalendarEventResponse, error) {
 	path := "/calendar/{groupId}/{calendarId}/follow"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -1408,7 +1572,7 @@ func (c *Client) FollowGroupCalendarEvent(params FollowGroupCalendarEventParams,
 	path = strings.ReplaceAll(path, "{calendarId}", fmt.Sprintf("%v", params.CalendarId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "FollowGroupCalendarEvent", "/calendar/{groupId}/{calendarId}/follow")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set request body
@@ -1418,36 +1582,40 @@ func (c *Client) FollowGroupCalendarEvent(params FollowGroupCalendarEventParams,
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodPost, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("FollowGroupCalendarEvent", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) GetSteamTransactions() (*TransactionListResponse, error) {
+	return c.GetSteamTransactionsWithContext(context.Background())
+}
+
+func (c *Client) GetSteamTransactionsWithContext(ctx context.Context) (*TransactionListResponse, error) {
 	path := "/Steam/transactions"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetSteamTransactions", "/Steam/transactions")
 	// Set response object
 	var result TransactionListResponse
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetSteamTransactions", resp)
 	}
 	return &result, nil
 }
@@ -1458,13 +1626,17 @@ type GetSteamTransactionParams struct {
 }
 
 func (c *Client) GetSteamTransaction(params GetSteamTransactionParams) (*TransactionResponse, error) {
+	return c.GetSteamTransactionWithContext(context.Background(), params)
+}
+
+func (c *Client) GetSteamTransactionWithContext(ctx context.Context, params GetSteamTransactionParams) (*TransactionResponse, error) {
 	path := "/Steam/transactions/{transactionId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{transactionId}", fmt.Sprintf("%v", params.TransactionId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetSteamTransaction", "/Steam/transactions/{transactionId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1472,36 +1644,40 @@ func (c *Client) GetSteamTransaction(params GetSteamTransactionParams) (*Transac
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetSteamTransaction", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) GetCurrentSubscriptions() (*UserSubscriptionListResponse, error) {
+	return c.GetCurrentSubscriptionsWithContext(context.Background())
+}
+
+func (c *Client) GetCurrentSubscriptionsWithContext(ctx context.Context) (*UserSubscriptionListResponse, error) {
 	path := "/auth/user/subscription"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetCurrentSubscriptions", "/auth/user/subscription")
 	// Set response object
 	var result UserSubscriptionListResponse
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetCurrentSubscriptions", resp)
 	}
 	return &result, nil
 }
@@ -1513,6 +1689,10 @@ type GetUserSubscriptionEligibleParams struct { // UserId A users unique ID, usu
 }
 
 func (c *Client) GetUserSubscriptionEligible(params GetUserSubscriptionEligibleParams) (*UserSubscriptionEligibleResponse, error) {
+	return c.GetUserSubscriptionEligibleWithContext(context.Background(), params)
+}
+
+func (c *Client) GetUserSubscriptionEligibleWithContext(ctx context.Context, params GetUserSubscriptionEligibleParams) (*UserSubscriptionEligibleResponse, error) {
 	path := "/users/{userId}/subscription/eligible"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -1522,7 +1702,7 @@ func (c *Client) GetUserSubscriptionEligible(params GetUserSubscriptionEligibleP
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetUserSubscriptionEligible", "/users/{userId}/subscription/eligible")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1530,36 +1710,40 @@ func (c *Client) GetUserSubscriptionEligible(params GetUserSubscriptionEligibleP
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetUserSubscriptionEligible", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) GetSubscriptions() (*SubscriptionListResponse, error) {
+	return c.GetSubscriptionsWithContext(context.Background())
+}
+
+func (c *Client) GetSubscriptionsWithContext(ctx context.Context) (*SubscriptionListResponse, error) {
 	path := "/subscriptions"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetSubscriptions", "/subscriptions")
 	// Set response object
 	var result SubscriptionListResponse
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetSubscriptions", resp)
 	}
 	return &result, nil
 }
@@ -1570,13 +1754,17 @@ type GetLicenseGroupParams struct {
 }
 
 func (c *Client) GetLicenseGroup(params GetLicenseGroupParams) (*LicenseGroupResponse, error) {
+	return c.GetLicenseGroupWithContext(context.Background(), params)
+}
+
+func (c *Client) GetLicenseGroupWithContext(ctx context.Context, params GetLicenseGroupParams) (*LicenseGroupResponse, error) {
 	path := "/licenseGroups/{licenseGroupId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{licenseGroupId}", fmt.Sprintf("%v", params.LicenseGroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetLicenseGroup", "/licenseGroups/{licenseGroupId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1584,35 +1772,39 @@ func (c *Client) GetLicenseGroup(params GetLicenseGroupParams) (*LicenseGroupRes
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetLicenseGroup", resp)
 	}
 	return &result, nil
 }
 
 // GetProductListingParams represents the parameters for the GetProductListing request
 type GetProductListingParams struct {
-	ProductId string `json:"productId"`
-	Hydrate   bool   `json:"hydrate"`
+	ProductId string         `json:"productId"`
+	Hydrate   Optional[bool] `json:"hydrate"`
 }
 
 func (c *Client) GetProductListing(params GetProductListingParams) (*ProductListingResponse, error) {
+	return c.GetProductListingWithContext(context.Background(), params)
+}
+
+func (c *Client) GetProductListingWithContext(ctx context.Context, params GetProductListingParams) (*ProductListingResponse, error) {
 	path := "/listing/{productId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{productId}", fmt.Sprintf("%v", params.ProductId))
-	if lo.IsNotEmpty(params.Hydrate) {
-		queryParams["hydrate"] = fmt.Sprintf("%v", params.Hydrate)
+	if v, ok := params.Hydrate.Get(); ok {
+		queryParams["hydrate"] = fmt.Sprintf("%v", v)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetProductListing", "/listing/{productId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1620,51 +1812,55 @@ func (c *Client) GetProductListing(params GetProductListingParams) (*ProductList
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetProductListing", resp)
 	}
 	return &result, nil
 }
 
 // GetProductListingsParams represents the parameters for the GetProductListings request
 type GetProductListingsParams struct { // UserId A users unique ID, usually in the form of `usr_c1644b5b-3ca4-45b4-97c6-a2a0de70d469`. Legacy players can have old IDs in the form of `8JoV9XEdpo`. The ID can never be changed.
-	UserId  UserId `json:"userId"`
-	N       int64  `json:"n"`
-	Offset  int64  `json:"offset"`
-	Hydrate bool   `json:"hydrate"`
-	GroupId string `json:"groupId"`
-	Active  bool   `json:"active"`
+	UserId  UserId          `json:"userId"`
+	N       Optional[int64] `json:"n"`
+	Offset  Optional[int64] `json:"offset"`
+	Hydrate Optional[bool]  `json:"hydrate"`
+	GroupId string          `json:"groupId"`
+	Active  Optional[bool]  `json:"active"`
 }
 
 func (c *Client) GetProductListings(params GetProductListingsParams) (*ProductListingListResponse, error) {
+	return c.GetProductListingsWithContext(context.Background(), params)
+}
+
+func (c *Client) GetProductListingsWithContext(ctx context.Context, params GetProductListingsParams) (*ProductListingListResponse, error) {
 	path := "/user/{userId}/listings"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if v, ok := params.N.Get(); ok {
+		queryParams["n"] = fmt.Sprintf("%v", v)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if v, ok := params.Offset.Get(); ok {
+		queryParams["offset"] = fmt.Sprintf("%v", v)
 	}
-	if lo.IsNotEmpty(params.Hydrate) {
-		queryParams["hydrate"] = fmt.Sprintf("%v", params.Hydrate)
+	if v, ok := params.Hydrate.Get(); ok {
+		queryParams["hydrate"] = fmt.Sprintf("%v", v)
 	}
 	if lo.IsNotEmpty(params.GroupId) {
 		queryParams["groupId"] = fmt.Sprintf("%v", params.GroupId)
 	}
-	if lo.IsNotEmpty(params.Active) {
-		queryParams["active"] = fmt.Sprintf("%v", params.Active)
+	if v, ok := params.Active.Get(); ok {
+		queryParams["active"] = fmt.Sprintf("%v", v)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetProductListings", "/user/{userId}/listings")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1672,58 +1868,66 @@ func (c *Client) GetProductListings(params GetProductListingsParams) (*ProductLi
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetProductListings", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) GetTokenBundles() (*TokenBundleListResponse, error) {
+	return c.GetTokenBundlesWithContext(context.Background())
+}
+
+func (c *Client) GetTokenBundlesWithContext(ctx context.Context) (*TokenBundleListResponse, error) {
 	path := "/tokenBundles"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetTokenBundles", "/tokenBundles")
 	// Set response object
 	var result TokenBundleListResponse
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetTokenBundles", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) GetTiliaStatus() (*TiliaStatusResponse, error) {
+	return c.GetTiliaStatusWithContext(context.Background())
+}
+
+func (c *Client) GetTiliaStatusWithContext(ctx context.Context) (*TiliaStatusResponse, error) {
 	path := "/tilia/status"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetTiliaStatus", "/tilia/status")
 	// Set response object
 	var result TiliaStatusResponse
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetTiliaStatus", resp)
 	}
 	return &result, nil
 }
@@ -1734,13 +1938,17 @@ type GetTiliaTosParams struct { // UserId A users unique ID, usually in the form
 }
 
 func (c *Client) GetTiliaTos(params GetTiliaTosParams) (*TiliaTosResponse, error) {
+	return c.GetTiliaTosWithContext(context.Background(), params)
+}
+
+func (c *Client) GetTiliaTosWithContext(ctx context.Context, params GetTiliaTosParams) (*TiliaTosResponse, error) {
 	path := "/user/{userId}/tilia/tos"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetTiliaTos", "/user/{userId}/tilia/tos")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1748,14 +1956,14 @@ func (c *Client) GetTiliaTos(params GetTiliaTosParams) (*TiliaTosResponse, error
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetTiliaTos", resp)
 	}
 	return &result, nil
 }
@@ -1766,13 +1974,17 @@ type GetBalanceParams struct { // UserId A users unique ID, usually in the form
 }
 
 func (c *Client) GetBalance(params GetBalanceParams) (*BalanceResponse, error) {
+	return c.GetBalanceWithContext(context.Background(), params)
+}
+
+func (c *Client) GetBalanceWithContext(ctx context.Context, params GetBalanceParams) (*BalanceResponse, error) {
 	path := "/user/{userId}/balance"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetBalance", "/user/{userId}/balance")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1780,14 +1992,14 @@ func (c *Client) GetBalance(params GetBalanceParams) (*BalanceResponse, error) {
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetBalance", resp)
 	}
 	return &result, nil
 }
@@ -1798,13 +2010,17 @@ type GetBalanceEarningsParams struct { // UserId A users unique ID, usually in t
 }
 
 func (c *Client) GetBalanceEarnings(params GetBalanceEarningsParams) (*BalanceResponse, error) {
+	return c.GetBalanceEarningsWithContext(context.Background(), params)
+}
+
+func (c *Client) GetBalanceEarningsWithContext(ctx context.Context, params GetBalanceEarningsParams) (*BalanceResponse, error) {
 	path := "/user/{userId}/balance/earnings"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetBalanceEarnings", "/user/{userId}/balance/earnings")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1812,14 +2028,14 @@ func (c *Client) GetBalanceEarnings(params GetBalanceEarningsParams) (*BalanceRe
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetBalanceEarnings", resp)
 	}
 	return &result, nil
 }
@@ -1830,13 +2046,17 @@ type GetEconomyAccountParams struct { // UserId A users unique ID, usually in th
 }
 
 func (c *Client) GetEconomyAccount(params GetEconomyAccountParams) (*EconomyAccountResponse, error) {
+	return c.GetEconomyAccountWithContext(context.Background(), params)
+}
+
+func (c *Client) GetEconomyAccountWithContext(ctx context.Context, params GetEconomyAccountParams) (*EconomyAccountResponse, error) {
 	path := "/user/{userId}/economy/account"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetEconomyAccount", "/user/{userId}/economy/account")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1844,63 +2064,71 @@ func (c *Client) GetEconomyAccount(params GetEconomyAccountParams) (*EconomyAcco
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetEconomyAccount", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) GetActiveLicenses() (*LicenseListResponse, error) {
+	return c.GetActiveLicensesWithContext(context.Background())
+}
+
+func (c *Client) GetActiveLicensesWithContext(ctx context.Context) (*LicenseListResponse, error) {
 	path := "/economy/licenses/active"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetActiveLicenses", "/economy/licenses/active")
 	// Set response object
 	var result LicenseListResponse
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetActiveLicenses", resp)
 	}
 	return &result, nil
 }
 
 // GetStoreParams represents the parameters for the GetStore request
 type GetStoreParams struct {
-	StoreId         StoreId `json:"storeId"`
-	HydrateListings bool    `json:"hydrateListings"`
-	HydrateProducts bool    `json:"hydrateProducts"`
+	StoreId         StoreId        `json:"storeId"`
+	HydrateListings Optional[bool] `json:"hydrateListings"`
+	HydrateProducts Optional[bool] `json:"hydrateProducts"`
 }
 
 func (c *Client) GetStore(params GetStoreParams) (*StoreResponse, error) {
+	return c.GetStoreWithContext(context.Background(), params)
+}
+
+func (c *Client) GetStoreWithContext(ctx context.Context, params GetStoreParams) (*StoreResponse, error) {
 	path := "/economy/store"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	if lo.IsNotEmpty(params.StoreId) {
 		queryParams["storeId"] = fmt.Sprintf("%v", params.StoreId)
 	}
-	if lo.IsNotEmpty(params.HydrateListings) {
-		queryParams["hydrateListings"] = fmt.Sprintf("%v", params.HydrateListings)
+	if v, ok := params.HydrateListings.Get(); ok {
+		queryParams["hydrateListings"] = fmt.Sprintf("%v", v)
 	}
-	if lo.IsNotEmpty(params.HydrateProducts) {
-		queryParams["hydrateProducts"] = fmt.Sprintf("%v", params.HydrateProducts)
+	if v, ok := params.HydrateProducts.Get(); ok {
+		queryParams["hydrateProducts"] = fmt.Sprintf("%v", v)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetStore", "/economy/store")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1908,41 +2136,45 @@ func (c *Client) GetStore(params GetStoreParams) (*StoreResponse, error) {
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetStore", resp)
 	}
 	return &result, nil
 }
 
 // GetStoreShelvesParams represents the parameters for the GetStoreShelves request
 type GetStoreShelvesParams struct {
-	StoreId         StoreId   `json:"storeId"`
-	HydrateListings bool      `json:"hydrateListings"`
-	Fetch           StoreView `json:"fetch"`
+	StoreId         StoreId        `json:"storeId"`
+	HydrateListings Optional[bool] `json:"hydrateListings"`
+	Fetch           StoreView      `json:"fetch"`
 }
 
 func (c *Client) GetStoreShelves(params GetStoreShelvesParams) (*StoreShelfListResponse, error) {
+	return c.GetStoreShelvesWithContext(context.Background(), params)
+}
+
+func (c *Client) GetStoreShelvesWithContext(ctx context.Context, params GetStoreShelvesParams) (*StoreShelfListResponse, error) {
 	path := "/economy/store/shelves"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	if lo.IsNotEmpty(params.StoreId) {
 		queryParams["storeId"] = fmt.Sprintf("%v", params.StoreId)
 	}
-	if lo.IsNotEmpty(params.HydrateListings) {
-		queryParams["hydrateListings"] = fmt.Sprintf("%v", params.HydrateListings)
+	if v, ok := params.HydrateListings.Get(); ok {
+		queryParams["hydrateListings"] = fmt.Sprintf("%v", v)
 	}
 	if lo.IsNotEmpty(params.Fetch) {
 		queryParams["fetch"] = fmt.Sprintf("%v", params.Fetch)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetStoreShelves", "/economy/store/shelves")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1950,41 +2182,45 @@ func (c *Client) GetStoreShelves(params GetStoreShelvesParams) (*StoreShelfListR
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetStoreShelves", resp)
 	}
 	return &result, nil
 }
 
 // GetFavoritesParams represents the parameters for the GetFavorites request
 type GetFavoritesParams struct {
-	N      int64  `json:"n"`
-	Offset int64  `json:"offset"`
-	Tag    string `json:"tag"`
+	N      Optional[int64] `json:"n"`
+	Offset Optional[int64] `json:"offset"`
+	Tag    string          `json:"tag"`
 }
 
 func (c *Client) GetFavorites(params GetFavoritesParams) (*FavoriteListResponse, error) {
+	return c.GetFavoritesWithContext(context.Background(), params)
+}
+
+func (c *Client) GetFavoritesWithContext(ctx context.Context, params GetFavoritesParams) (*FavoriteListResponse, error) {
 	path := "/favorites"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if v, ok := params.N.Get(); ok {
+		queryParams["n"] = fmt.Sprintf("%v", v)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if v, ok := params.Offset.Get(); ok {
+		queryParams["offset"] = fmt.Sprintf("%v", v)
 	}
 	if lo.IsNotEmpty(params.Tag) {
 		queryParams["tag"] = fmt.Sprintf("%v", params.Tag)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetFavorites", "/favorites")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1992,23 +2228,27 @@ func (c *Client) GetFavorites(params GetFavoritesParams) (*FavoriteListResponse,
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetFavorites", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) AddFavorite(body AddFavoriteRequest) (*FavoriteResponse, error) {
+	return c.AddFavoriteWithContext(context.Background(), body)
+}
+
+func (c *Client) AddFavoriteWithContext(ctx context.Context, body AddFavoriteRequest) (*FavoriteResponse, error) {
 	path := "/favorites"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "AddFavorite", "/favorites")
 	// Set request body
 	req.SetBody(body)
 	// Set response object
@@ -2016,14 +2256,14 @@ func (c *Client) AddFavorite(body AddFavoriteRequest) (*FavoriteResponse, error)
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodPost, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("AddFavorite", resp)
 	}
 	return &result, nil
 }
@@ -2034,13 +2274,17 @@ type RemoveFavoriteParams struct {
 }
 
 func (c *Client) RemoveFavorite(params RemoveFavoriteParams) (*FavoriteRemovedSuccess, error) {
+	return c.RemoveFavoriteWithContext(context.Background(), params)
+}
+
+func (c *Client) RemoveFavoriteWithContext(ctx context.Context, params RemoveFavoriteParams) (*FavoriteRemovedSuccess, error) {
 	path := "/favorites/{favoriteId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{favoriteId}", fmt.Sprintf("%v", params.FavoriteId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "RemoveFavorite", "/favorites/{favoriteId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -2048,41 +2292,45 @@ func (c *Client) RemoveFavorite(params RemoveFavoriteParams) (*FavoriteRemovedSu
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Delete(path)
+	resp, err := c.send(req, resty.MethodDelete, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("RemoveFavorite", resp)
 	}
 	return &result, nil
 }
 
 // GetFavoriteGroupsParams represents the parameters for the GetFavoriteGroups request
 type GetFavoriteGroupsParams struct {
-	N      int64  `json:"n"`
-	Offset int64  `json:"offset"` // UserId A users unique ID, usually in the form of `usr_c1644b5b-3ca4-45b4-97c6-a2a0de70d469`. Legacy players can have old IDs in the form of `8JoV9XEdpo`. The ID can never be changed.
-	UserId UserId `json:"userId"`
+	N      Optional[int64] `json:"n"`
+	Offset Optional[int64] `json:"offset"` // UserId A users unique ID, usually in the form of `usr_c1644b5b-3ca4-45b4-97c6-a2a0de70d469`. Legacy players can have old IDs in the form of `8JoV9XEdpo`. The ID can never be changed.
+	UserId UserId          `json:"userId"`
 }
 
 func (c *Client) GetFavoriteGroups(params GetFavoriteGroupsParams) (*FavoriteGroupListResponse, error) {
+	return c.GetFavoriteGroupsWithContext(context.Background(), params)
+}
+
+func (c *Client) GetFavoriteGroupsWithContext(ctx context.Context, params GetFavoriteGroupsParams) (*FavoriteGroupListResponse, error) {
 	path := "/favorite/groups"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if v, ok := params.N.Get(); ok {
+		queryParams["n"] = fmt.Sprintf("%v", v)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if v, ok := params.Offset.Get(); ok {
+		queryParams["offset"] = fmt.Sprintf("%v", v)
 	}
 	if lo.IsNotEmpty(params.UserId) {
 		queryParams["userId"] = fmt.Sprintf("%v", params.UserId)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetFavoriteGroups", "/favorite/groups")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -2090,14 +2338,14 @@ func (c *Client) GetFavoriteGroups(params GetFavoriteGroupsParams) (*FavoriteGro
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetFavoriteGroups", resp)
 	}
 	return &result, nil
 }
@@ -2111,6 +2359,10 @@ type ClearFavoriteGroupParams struct {
 }
 
 func (c *Client) ClearFavoriteGroup(params ClearFavoriteGroupParams) (*FavoriteGroupClearedSuccess, error) {
+	return c.ClearFavoriteGroupWithContext(context.Background(), params)
+}
+
+func (c *Client) ClearFavoriteGroupWithContext(ctx context.Context, params ClearFavoriteGroupParams) (*FavoriteGroupClearedSuccess, error) {
 	path := "/favorite/group/{favoriteGroupType}/{favoriteGroupName}/{userId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -2119,7 +2371,7 @@ func (c *Client) ClearFavoriteGroup(params ClearFavoriteGroupParams) (*FavoriteG
 	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "ClearFavoriteGroup", "/favorite/group/{favoriteGroupType}/{favoriteGroupName}/{userId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -2127,14 +2379,14 @@ func (c *Client) ClearFavoriteGroup(params ClearFavoriteGroupParams) (*FavoriteG
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Delete(path)
+	resp, err := c.send(req, resty.MethodDelete, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("ClearFavoriteGroup", resp)
 	}
 	return &result, nil
 }
@@ -2148,6 +2400,10 @@ type GetFavoriteGroupParams struct {
 }
 
 func (c *Client) GetFavoriteGroup(params GetFavoriteGroupParams) (*FavoriteGroupResponse, error) {
+	return c.GetFavoriteGroupWithContext(context.Background(), params)
+}
+
+func (c *Client) GetFavoriteGroupWithContext(ctx context.Context, params GetFavoriteGroupParams) (*FavoriteGroupResponse, error) {
 	path := "/favorite/group/{favoriteGroupType}/{favoriteGroupName}/{userId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -2156,7 +2412,7 @@ func (c *Client) GetFavoriteGroup(params GetFavoriteGroupParams) (*FavoriteGroup
 	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetFavoriteGroup", "/favorite/group/{favoriteGroupType}/{favoriteGroupName}/{userId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -2164,14 +2420,14 @@ func (c *Client) GetFavoriteGroup(params GetFavoriteGroupParams) (*FavoriteGroup
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetFavoriteGroup", resp)
 	}
 	return &result, nil
 }
@@ -2185,6 +2441,10 @@ type UpdateFavoriteGroupParams struct {
 }
 
 func (c *Client) UpdateFavoriteGroup(params UpdateFavoriteGroupParams, body UpdateFavoriteGroupRequest) error {
+	return c.UpdateFavoriteGroupWithContext(context.Background(), params, body)
+}
+
+func (c *Client) UpdateFavoriteGroupWithContext(ctx context.Context, params UpdateFavoriteGroupParams, body UpdateFavoriteGroupRequest) error {
 	path := "/favorite/group/{favoriteGroupType}/{favoriteGroupName}/{userId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -2193,66 +2453,74 @@ func (c *Client) UpdateFavoriteGroup(params UpdateFavoriteGroupParams, body Upda
 	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "UpdateFavoriteGroup", "/favorite/group/{favoriteGroupType}/{favoriteGroupName}/{userId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set request body
 	req.SetBody(body)
 
 	// Send request
-	resp, err := req.Put(path)
+	resp, err := c.send(req, resty.MethodPut, path)
 	if err != nil {
 		return fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return newAPIError("UpdateFavoriteGroup", resp)
 	}
 	return nil
 }
 
 func (c *Client) GetFavoriteLimits() (*FavoriteLimitsResponse, error) {
+	return c.GetFavoriteLimitsWithContext(context.Background())
+}
+
+func (c *Client) GetFavoriteLimitsWithContext(ctx context.Context) (*FavoriteLimitsResponse, error) {
 	path := "/auth/user/favoritelimits"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetFavoriteLimits", "/auth/user/favoritelimits")
 	// Set response object
 	var result FavoriteLimitsResponse
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetFavoriteLimits", resp)
 	}
 	return &result, nil
 }
 
 // GetFilesParams represents the parameters for the GetFiles request
 type GetFilesParams struct {
-	N      int64 `json:"n"`
-	Offset int64 `json:"offset"`
+	N      Optional[int64] `json:"n"`
+	Offset Optional[int64] `json:"offset"`
 }
 
 func (c *Client) GetFiles(params GetFilesParams) (*FileListResponse, error) {
+	return c.GetFilesWithContext(context.Background(), params)
+}
+
+func (c *Client) GetFilesWithContext(ctx context.Context, params GetFilesParams) (*FileListResponse, error) {
 	path := "/files"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if v, ok := params.N.Get(); ok {
+		queryParams["n"] = fmt.Sprintf("%v", v)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if v, ok := params.Offset.Get(); ok {
+		queryParams["offset"] = fmt.Sprintf("%v", v)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetFiles", "/files")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -2260,23 +2528,27 @@ func (c *Client) GetFiles(params GetFilesParams) (*FileListResponse, error) {
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetFiles", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) CreateFile(body CreateFileRequest) (*FileResponse, error) {
+	return c.CreateFileWithContext(context.Background(), body)
+}
+
+func (c *Client) CreateFileWithContext(ctx context.Context, body CreateFileRequest) (*FileResponse, error) {
 	path := "/file"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "CreateFile", "/file")
 	// Set request body
 	req.SetBody(body)
 	// Set response object
@@ -2284,14 +2556,14 @@ func (c *Client) CreateFile(body CreateFileRequest) (*FileResponse, error) {
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodPost, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("CreateFile", resp)
 	}
 	return &result, nil
 }
@@ -2302,13 +2574,17 @@ type DeleteFileParams struct {
 }
 
 func (c *Client) DeleteFile(params DeleteFileParams) (*FileResponse, error) {
+	return c.DeleteFileWithContext(context.Background(), params)
+}
+
+func (c *Client) DeleteFileWithContext(ctx context.Context, params DeleteFileParams) (*FileResponse, error) {
 	path := "/file/{fileId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{fileId}", fmt.Sprintf("%v", params.FileId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "DeleteFile", "/file/{fileId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -2316,14 +2592,14 @@ func (c *Client) DeleteFile(params DeleteFileParams) (*FileResponse, error) {
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Delete(path)
+	resp, err := c.send(req, resty.MethodDelete, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("DeleteFile", resp)
 	}
 	return &result, nil
 }
@@ -2334,13 +2610,17 @@ type GetFileParams struct {
 }
 
 func (c *Client) GetFile(params GetFileParams) (*FileResponse, error) {
+	return c.GetFileWithContext(context.Background(), params)
+}
+
+func (c *Client) GetFileWithContext(ctx context.Context, params GetFileParams) (*FileResponse, error) {
 	path := "/file/{fileId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{fileId}", fmt.Sprintf("%v", params.FileId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetFile", "/file/{fileId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -2348,14 +2628,14 @@ func (c *Client) GetFile(params GetFileParams) (*FileResponse, error) {
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetFile", resp)
 	}
 	return &result, nil
 }
@@ -2366,13 +2646,17 @@ type CreateFileVersionParams struct {
 }
 
 func (c *Client) CreateFileVersion(params CreateFileVersionParams, body CreateFileVersionRequest) (*FileResponse, error) {
+	return c.CreateFileVersionWithContext(context.Background(), params, body)
+}
+
+func (c *Client) CreateFileVersionWithContext(ctx context.Context, params CreateFileVersionParams, body CreateFileVersionRequest) (*FileResponse, error) {
 	path := "/file/{fileId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{fileId}", fmt.Sprintf("%v", params.FileId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "CreateFileVersion", "/file/{fileId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set request body
@@ -2382,14 +2666,14 @@ func (c *Client) CreateFileVersion(params CreateFileVersionParams, body CreateFi
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodPost, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("CreateFileVersion", resp)
 	}
 	return &result, nil
 }
@@ -2401,6 +2685,10 @@ type DeleteFileVersionParams struct {
 }
 
 func (c *Client) DeleteFileVersion(params DeleteFileVersionParams) (*FileResponse, error) {
+	return c.DeleteFileVersionWithContext(context.Background(), params)
+}
+
+func (c *Client) DeleteFileVersionWithContext(ctx context.Context, params DeleteFileVersionParams) (*FileResponse, error) {
 	path := "/file/{fileId}/{versionId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -2408,7 +2696,7 @@ func (c *Client) DeleteFileVersion(params DeleteFileVersionParams) (*FileRespons
 	path = strings.ReplaceAll(path, "{versionId}", fmt.Sprintf("%v", params.VersionId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "DeleteFileVersion", "/file/{fileId}/{versionId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -2416,14 +2704,14 @@ func (c *Client) DeleteFileVersion(params DeleteFileVersionParams) (*FileRespons
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Delete(path)
+	resp, err := c.send(req, resty.MethodDelete, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("DeleteFileVersion", resp)
 	}
 	return &result, nil
 }
@@ -2435,6 +2723,10 @@ type DownloadFileVersionParams struct {
 }
 
 func (c *Client) DownloadFileVersion(params DownloadFileVersionParams) (*RawFileResponse, error) {
+	return c.DownloadFileVersionWithContext(context.Background(), params)
+}
+
+func (c *Client) DownloadFileVersionWithContext(ctx context.Context, params DownloadFileVersionParams) (*RawFileResponse, error) {
 	path := "/file/{fileId}/{versionId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -2442,7 +2734,7 @@ func (c *Client) DownloadFileVersion(params DownloadFileVersionParams) (*RawFile
 	path = strings.ReplaceAll(path, "{versionId}", fmt.Sprintf("%v", params.VersionId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "DownloadFileVersion", "/file/{fileId}/{versionId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -2450,14 +2742,14 @@ func (c *Client) DownloadFileVersion(params DownloadFileVersionParams) (*RawFile
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("DownloadFileVersion", resp)
 	}
 	return &result, nil
 }
@@ -2471,6 +2763,10 @@ type FinishFileDataUploadParams struct {
 }
 
 func (c *Client) FinishFileDataUpload(params FinishFileDataUploadParams, body FinishFileDataUploadRequest) (*FileResponse, error) {
+	return c.FinishFileDataUploadWithContext(context.Background(), params, body)
+}
+
+func (c *Client) FinishFileDataUploadWithContext(ctx context.Context, params FinishFileDataUploadParams, body FinishFileDataUploadRequest) (*FileResponse, error) {
 	path := "/file/{fileId}/{versionId}/{fileType}/finish"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -2479,7 +2775,7 @@ func (c *Client) FinishFileDataUpload(params FinishFileDataUploadParams, body Fi
 	path = strings.ReplaceAll(path, "{fileType}", fmt.Sprintf("%v", params.FileType))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "FinishFileDataUpload", "/file/{fileId}/{versionId}/{fileType}/finish")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set request body
@@ -2489,14 +2785,14 @@ func (c *Client) FinishFileDataUpload(params FinishFileDataUploadParams, body Fi
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Put(path)
+	resp, err := c.send(req, resty.MethodPut, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("FinishFileDataUpload", resp)
 	}
 	return &result, nil
 }
@@ -2510,6 +2806,10 @@ type StartFileDataUploadParams struct {
 }
 
 func (c *Client) StartFileDataUpload(params StartFileDataUploadParams) (*FileUploadUrlResponse, error) {
+	return c.StartFileDataUploadWithContext(context.Background(), params)
+}
+
+func (c *Client) StartFileDataUploadWithContext(ctx context.Context, params StartFileDataUploadParams) (*FileUploadUrlResponse, error) {
 	path := "/file/{fileId}/{versionId}/{fileType}/start"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -2518,7 +2818,7 @@ func (c *Client) StartFileDataUpload(params StartFileDataUploadParams) (*FileUpl
 	path = strings.ReplaceAll(path, "{fileType}", fmt.Sprintf("%v", params.FileType))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "StartFileDataUpload", "/file/{fileId}/{versionId}/{fileType}/start")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -2526,14 +2826,14 @@ func (c *Client) StartFileDataUpload(params StartFileDataUploadParams) (*FileUpl
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Put(path)
+	resp, err := c.send(req, resty.MethodPut, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("StartFileDataUpload", resp)
 	}
 	return &result, nil
 }
@@ -2547,6 +2847,10 @@ type GetFileDataUploadStatusParams struct {
 }
 
 func (c *Client) GetFileDataUploadStatus(params GetFileDataUploadStatusParams) (*FileVersionUploadStatusResponse, error) {
+	return c.GetFileDataUploadStatusWithContext(context.Background(), params)
+}
+
+func (c *Client) GetFileDataUploadStatusWithContext(ctx context.Context, params GetFileDataUploadStatusParams) (*FileVersionUploadStatusResponse, error) {
 	path := "/file/{fileId}/{versionId}/{fileType}/status"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -2555,7 +2859,7 @@ func (c *Client) GetFileDataUploadStatus(params GetFileDataUploadStatusParams) (
 	path = strings.ReplaceAll(path, "{fileType}", fmt.Sprintf("%v", params.FileType))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetFileDataUploadStatus", "/file/{fileId}/{versionId}/{fileType}/status")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -2563,14 +2867,14 @@ func (c *Client) GetFileDataUploadStatus(params GetFileDataUploadStatusParams) (
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetFileDataUploadStatus", resp)
 	}
 	return &result, nil
 }
@@ -2582,6 +2886,10 @@ type GetFileAnalysisParams struct {
 }
 
 func (c *Client) GetFileAnalysis(params GetFileAnalysisParams) (*FileAnalysisResponse, error) {
+	return c.GetFileAnalysisWithContext(context.Background(), params)
+}
+
+func (c *Client) GetFileAnalysisWithContext(ctx context.Context, params GetFileAnalysisParams) (*FileAnalysisResponse, error) {
 	path := "/analysis/{fileId}/{versionId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -2589,7 +2897,7 @@ func (c *Client) GetFileAnalysis(params GetFileAnalysisParams) (*FileAnalysisRes
 	path = strings.ReplaceAll(path, "{versionId}", fmt.Sprintf("%v", params.VersionId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetFileAnalysis", "/analysis/{fileId}/{versionId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -2597,14 +2905,14 @@ func (c *Client) GetFileAnalysis(params GetFileAnalysisParams) (*FileAnalysisRes
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetFileAnalysis", resp)
 	}
 	return &result, nil
 }
@@ -2616,6 +2924,10 @@ type GetFileAnalysisSecurityParams struct {
 }
 
 func (c *Client) GetFileAnalysisSecurity(params GetFileAnalysisSecurityParams) (*FileAnalysisResponse, error) {
+	return c.GetFileAnalysisSecurityWithContext(context.Background(), params)
+}
+
+func (c *Client) GetFileAnalysisSecurityWithContext(ctx context.Context, params GetFileAnalysisSecurityParams) (*FileAnalysisResponse, error) {
 	path := "/analysis/{fileId}/{versionId}/security"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -2623,7 +2935,7 @@ func (c *Client) GetFileAnalysisSecurity(params GetFileAnalysisSecurityParams) (
 	path = strings.ReplaceAll(path, "{versionId}", fmt.Sprintf("%v", params.VersionId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetFileAnalysisSecurity", "/analysis/{fileId}/{versionId}/security")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -2631,14 +2943,14 @@ func (c *Client) GetFileAnalysisSecurity(params GetFileAnalysisSecurityParams) (
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetFileAnalysisSecurity", resp)
 	}
 	return &result, nil
 }
@@ -2650,6 +2962,10 @@ type GetFileAnalysisStandardParams struct {
 }
 
 func (c *Client) GetFileAnalysisStandard(params GetFileAnalysisStandardParams) (*FileAnalysisResponse, error) {
+	return c.GetFileAnalysisStandardWithContext(context.Background(), params)
+}
+
+func (c *Client) GetFileAnalysisStandardWithContext(ctx context.Context, params GetFileAnalysisStandardParams) (*FileAnalysisResponse, error) {
 	path := "/analysis/{fileId}/{versionId}/standard"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -2657,7 +2973,7 @@ func (c *Client) GetFileAnalysisStandard(params GetFileAnalysisStandardParams) (
 	path = strings.ReplaceAll(path, "{versionId}", fmt.Sprintf("%v", params.VersionId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetFileAnalysisStandard", "/analysis/{fileId}/{versionId}/standard")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -2665,80 +2981,14 @@ func (c *Client) GetFileAnalysisStandard(params GetFileAnalysisStandardParams) (
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
-	if err != nil {
-		return nil, fmt.Errorf("error sending request: %w", err)
-	}
-
-	// Check for successful status code
-	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
-	}
-	return &result, nil
-}
-
-func (c *Client) UploadImage() (*FileResponse, error) {
-	path := "/file/image"
-
-	// Create request
-	req := c.client.R()
-	// Set response object
-	var result FileResponse
-	req.SetResult(&result)
-
-	// Send request
-	resp, err := req.Post(path)
-	if err != nil {
-		return nil, fmt.Errorf("error sending request: %w", err)
-	}
-
-	// Check for successful status code
-	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
-	}
-	return &result, nil
-}
-
-func (c *Client) UploadIcon() (*FileResponse, error) {
-	path := "/icon"
-
-	// Create request
-	req := c.client.R()
-	// Set response object
-	var result FileResponse
-	req.SetResult(&result)
-
-	// Send request
-	resp, err := req.Post(path)
-	if err != nil {
-		return nil, fmt.Errorf("error sending request: %w", err)
-	}
-
-	// Check for successful status code
-	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
-	}
-	return &result, nil
-}
-
-func (c *Client) UploadGalleryImage() (*FileResponse, error) {
-	path := "/gallery"
-
-	// Create request
-	req := c.client.R()
-	// Set response object
-	var result FileResponse
-	req.SetResult(&result)
-
-	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetFileAnalysisStandard", resp)
 	}
 	return &result, nil
 }
@@ -2749,13 +2999,17 @@ type GetAdminAssetBundleParams struct {
 }
 
 func (c *Client) GetAdminAssetBundle(params GetAdminAssetBundleParams) (*AdminAssetBundleResponse, error) {
+	return c.GetAdminAssetBundleWithContext(context.Background(), params)
+}
+
+func (c *Client) GetAdminAssetBundleWithContext(ctx context.Context, params GetAdminAssetBundleParams) (*AdminAssetBundleResponse, error) {
 	path := "/adminassetbundles/{adminAssetBundleId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{adminAssetBundleId}", fmt.Sprintf("%v", params.AdminAssetBundleId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetAdminAssetBundle", "/adminassetbundles/{adminAssetBundleId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -2763,41 +3017,45 @@ func (c *Client) GetAdminAssetBundle(params GetAdminAssetBundleParams) (*AdminAs
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetAdminAssetBundle", resp)
 	}
 	return &result, nil
 }
 
 // GetFriendsParams represents the parameters for the GetFriends request
 type GetFriendsParams struct {
-	Offset  int64 `json:"offset"`
-	N       int64 `json:"n"`
-	Offline bool  `json:"offline"`
+	Offset  Optional[int64] `json:"offset"`
+	N       Optional[int64] `json:"n"`
+	Offline Optional[bool]  `json:"offline"`
 }
 
 func (c *Client) GetFriends(params GetFriendsParams) (*LimitedUserFriendListResponse, error) {
+	return c.GetFriendsWithContext(context.Background(), params)
+}
+
+func (c *Client) GetFriendsWithContext(ctx context.Context, params GetFriendsParams) (*LimitedUserFriendListResponse, error) {
 	path := "/auth/user/friends"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if v, ok := params.Offset.Get(); ok {
+		queryParams["offset"] = fmt.Sprintf("%v", v)
 	}
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if v, ok := params.N.Get(); ok {
+		queryParams["n"] = fmt.Sprintf("%v", v)
 	}
-	if lo.IsNotEmpty(params.Offline) {
-		queryParams["offline"] = fmt.Sprintf("%v", params.Offline)
+	if v, ok := params.Offline.Get(); ok {
+		queryParams["offline"] = fmt.Sprintf("%v", v)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetFriends", "/auth/user/friends")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -2805,14 +3063,14 @@ func (c *Client) GetFriends(params GetFriendsParams) (*LimitedUserFriendListResp
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetFriends", resp)
 	}
 	return &result, nil
 }
@@ -2823,13 +3081,17 @@ type DeleteFriendRequestParams struct { // UserId A users unique ID, usually in
 }
 
 func (c *Client) DeleteFriendRequest(params DeleteFriendRequestParams) (*DeleteFriendSuccess, error) {
+	return c.DeleteFriendRequestWithContext(context.Background(), params)
+}
+
+func (c *Client) DeleteFriendRequestWithContext(ctx context.Context, params DeleteFriendRequestParams) (*DeleteFriendSuccess, error) {
 	path := "/user/{userId}/friendRequest"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "DeleteFriendRequest", "/user/{userId}/friendRequest")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -2837,14 +3099,14 @@ func (c *Client) DeleteFriendRequest(params DeleteFriendRequestParams) (*DeleteF
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Delete(path)
+	resp, err := c.send(req, resty.MethodDelete, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("DeleteFriendRequest", resp)
 	}
 	return &result, nil
 }
@@ -2855,13 +3117,17 @@ type FriendParams struct { // UserId A users unique ID, usually in the form of `
 }
 
 func (c *Client) Friend(params FriendParams) (*NotificationResponse, error) {
+	return c.FriendWithContext(context.Background(), params)
+}
+
+func (c *Client) FriendWithContext(ctx context.Context, params FriendParams) (*NotificationResponse, error) {
 	path := "/user/{userId}/friendRequest"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "Friend", "/user/{userId}/friendRequest")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -2869,14 +3135,14 @@ func (c *Client) Friend(params FriendParams) (*NotificationResponse, error) {
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodPost, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("Friend", resp)
 	}
 	return &result, nil
 }
@@ -2887,13 +3153,17 @@ type GetFriendStatusParams struct { // UserId A users unique ID, usually in the
 }
 
 func (c *Client) GetFriendStatus(params GetFriendStatusParams) (*FriendStatusResponse, error) {
+	return c.GetFriendStatusWithContext(context.Background(), params)
+}
+
+func (c *Client) GetFriendStatusWithContext(ctx context.Context, params GetFriendStatusParams) (*FriendStatusResponse, error) {
 	path := "/user/{userId}/friendStatus"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetFriendStatus", "/user/{userId}/friendStatus")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -2901,14 +3171,14 @@ func (c *Client) GetFriendStatus(params GetFriendStatusParams) (*FriendStatusRes
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetFriendStatus", resp)
 	}
 	return &result, nil
 }
@@ -2919,13 +3189,17 @@ type UnfriendParams struct { // UserId A users unique ID, usually in the form of
 }
 
 func (c *Client) Unfriend(params UnfriendParams) (*UnfriendSuccess, error) {
+	return c.UnfriendWithContext(context.Background(), params)
+}
+
+func (c *Client) UnfriendWithContext(ctx context.Context, params UnfriendParams) (*UnfriendSuccess, error) {
 	path := "/auth/user/friends/{userId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "Unfriend", "/auth/user/friends/{userId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -2933,37 +3207,41 @@ func (c *Client) Unfriend(params UnfriendParams) (*UnfriendSuccess, error) {
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Delete(path)
+	resp, err := c.send(req, resty.MethodDelete, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("Unfriend", resp)
 	}
 	return &result, nil
 }
 
 // SearchGroupsParams represents the parameters for the SearchGroups request
 type SearchGroupsParams struct {
-	Offset int64 `json:"offset"`
-	N      int64 `json:"n"`
+	Offset Optional[int64] `json:"offset"`
+	N      Optional[int64] `json:"n"`
 }
 
 func (c *Client) SearchGroups(params SearchGroupsParams) (*LimitedGroupListResponse, error) {
+	return c.SearchGroupsWithContext(context.Background(), params)
+}
+
+func (c *Client) SearchGroupsWithContext(ctx context.Context, params SearchGroupsParams) (*LimitedGroupListResponse, error) {
 	path := "/groups"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if v, ok := params.Offset.Get(); ok {
+		queryParams["offset"] = fmt.Sprintf("%v", v)
 	}
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if v, ok := params.N.Get(); ok {
+		queryParams["n"] = fmt.Sprintf("%v", v)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "SearchGroups", "/groups")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -2971,23 +3249,27 @@ func (c *Client) SearchGroups(params SearchGroupsParams) (*LimitedGroupListRespo
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("SearchGroups", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) CreateGroup(body CreateGroupRequest) (*GroupResponse, error) {
+	return c.CreateGroupWithContext(context.Background(), body)
+}
+
+func (c *Client) CreateGroupWithContext(ctx context.Context, body CreateGroupRequest) (*GroupResponse, error) {
 	path := "/groups"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "CreateGroup", "/groups")
 	// Set request body
 	req.SetBody(body)
 	// Set response object
@@ -2995,36 +3277,40 @@ func (c *Client) CreateGroup(body CreateGroupRequest) (*GroupResponse, error) {
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodPost, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("CreateGroup", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) GetGroupRoleTemplates() (*GroupRoleTemplatesResponse, error) {
+	return c.GetGroupRoleTemplatesWithContext(context.Background())
+}
+
+func (c *Client) GetGroupRoleTemplatesWithContext(ctx context.Context) (*GroupRoleTemplatesResponse, error) {
 	path := "/groups/roleTemplates"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetGroupRoleTemplates", "/groups/roleTemplates")
 	// Set response object
 	var result GroupRoleTemplatesResponse
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetGroupRoleTemplates", resp)
 	}
 	return &result, nil
 }
@@ -3035,13 +3321,17 @@ type DeleteGroupParams struct {
 }
 
 func (c *Client) DeleteGroup(params DeleteGroupParams) (*DeleteGroupSuccess, error) {
+	return c.DeleteGroupWithContext(context.Background(), params)
+}
+
+func (c *Client) DeleteGroupWithContext(ctx context.Context, params DeleteGroupParams) (*DeleteGroupSuccess, error) {
 	path := "/groups/{groupId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "DeleteGroup", "/groups/{groupId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -3049,14 +3339,14 @@ func (c *Client) DeleteGroup(params DeleteGroupParams) (*DeleteGroupSuccess, err
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Delete(path)
+	resp, err := c.send(req, resty.MethodDelete, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("DeleteGroup", resp)
 	}
 	return &result, nil
 }
@@ -3067,13 +3357,17 @@ type GetGroupParams struct {
 }
 
 func (c *Client) GetGroup(params GetGroupParams) (*GroupResponse, error) {
+	return c.GetGroupWithContext(context.Background(), params)
+}
+
+func (c *Client) GetGroupWithContext(ctx context.Context, params GetGroupParams) (*GroupResponse, error) {
 	path := "/groups/{groupId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetGroup", "/groups/{groupId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -3081,14 +3375,14 @@ func (c *Client) GetGroup(params GetGroupParams) (*GroupResponse, error) {
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetGroup", resp)
 	}
 	return &result, nil
 }
@@ -3099,13 +3393,17 @@ type UpdateGroupParams struct {
 }
 
 func (c *Client) UpdateGroup(params UpdateGroupParams, body UpdateGroupRequest) (*GroupResponse, error) {
+	return c.UpdateGroupWithContext(context.Background(), params, body)
+}
+
+func (c *Client) UpdateGroupWithContext(ctx context.Context, params UpdateGroupParams, body UpdateGroupRequest) (*GroupResponse, error) {
 	path := "/groups/{groupId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "UpdateGroup", "/groups/{groupId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set request body
@@ -3115,14 +3413,14 @@ func (c *Client) UpdateGroup(params UpdateGroupParams, body UpdateGroupRequest)
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Put(path)
+	resp, err := c.send(req, resty.MethodPut, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("UpdateGroup", resp)
 	}
 	return &result, nil
 }
@@ -3133,13 +3431,17 @@ type DeleteGroupAnnouncementParams struct {
 }
 
 func (c *Client) DeleteGroupAnnouncement(params DeleteGroupAnnouncementParams) (*DeleteGroupAnnouncementSuccess, error) {
+	return c.DeleteGroupAnnouncementWithContext(context.Background(), params)
+}
+
+func (c *Client) DeleteGroupAnnouncementWithContext(ctx context.Context, params DeleteGroupAnnouncementParams) (*DeleteGroupAnnouncementSuccess, error) {
 	path := "/groups/{groupId}/announcement"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "DeleteGroupAnnouncement", "/groups/{groupId}/announcement")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -3147,14 +3449,14 @@ func (c *Client) DeleteGroupAnnouncement(params DeleteGroupAnnouncementParams) (
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Delete(path)
+	resp, err := c.send(req, resty.MethodDelete, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("DeleteGroupAnnouncement", resp)
 	}
 	return &result, nil
 }
@@ -3165,13 +3467,17 @@ type GetGroupAnnouncementsParams struct {
 }
 
 func (c *Client) GetGroupAnnouncements(params GetGroupAnnouncementsParams) (*GroupAnnouncementResponse, error) {
+	return c.GetGroupAnnouncementsWithContext(context.Background(), params)
+}
+
+func (c *Client) GetGroupAnnouncementsWithContext(ctx context.Context, params GetGroupAnnouncementsParams) (*GroupAnnouncementResponse, error) {
 	path := "/groups/{groupId}/announcement"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetGroupAnnouncements", "/groups/{groupId}/announcement")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -3179,14 +3485,14 @@ func (c *Client) GetGroupAnnouncements(params GetGroupAnnouncementsParams) (*Gro
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetGroupAnnouncements", resp)
 	}
 	return &result, nil
 }
@@ -3197,13 +3503,17 @@ type CreateGroupAnnouncementParams struct {
 }
 
 func (c *Client) CreateGroupAnnouncement(params CreateGroupAnnouncementParams, body CreateGroupAnnouncementRequest) (*GroupAnnouncementResponse, error) {
+	return c.CreateGroupAnnouncementWithContext(context.Background(), params, body)
+}
+
+func (c *Client) CreateGroupAnnouncementWithContext(ctx context.Context, params CreateGroupAnnouncementParams, body CreateGroupAnnouncementRequest) (*GroupAnnouncementResponse, error) {
 	path := "/groups/{groupId}/announcement"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "CreateGroupAnnouncement", "/groups/{groupId}/announcement")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set request body
@@ -3213,40 +3523,44 @@ func (c *Client) CreateGroupAnnouncement(params CreateGroupAnnouncementParams, b
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodPost, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("CreateGroupAnnouncement", resp)
 	}
 	return &result, nil
 }
 
 // GetGroupAuditLogsParams represents the parameters for the GetGroupAuditLogs request
 type GetGroupAuditLogsParams struct {
-	GroupId    string    `json:"groupId"`
-	N          int64     `json:"n"`
-	Offset     int64     `json:"offset"`
-	StartDate  time.Time `json:"startDate"`
-	EndDate    time.Time `json:"endDate"`
-	ActorIds   string    `json:"actorIds"`
-	EventTypes string    `json:"eventTypes"`
-	TargetIds  string    `json:"targetIds"`
+	GroupId    string          `json:"groupId"`
+	N          Optional[int64] `json:"n"`
+	Offset     Optional[int64] `json:"offset"`
+	StartDate  time.Time       `json:"startDate"`
+	EndDate    time.Time       `json:"endDate"`
+	ActorIds   string          `json:"actorIds"`
+	EventTypes string          `json:"eventTypes"`
+	TargetIds  string          `json:"targetIds"`
 }
 
 func (c *Client) GetGroupAuditLogs(params GetGroupAuditLogsParams) (*GroupAuditLogListResponse, error) {
+	return c.GetGroupAuditLogsWithContext(context.Background(), params)
+}
+
+func (c *Client) GetGroupAuditLogsWithContext(ctx context.Context, params GetGroupAuditLogsParams) (*GroupAuditLogListResponse, error) {
 	path := "/groups/{groupId}/auditLogs"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if v, ok := params.N.Get(); ok {
+		queryParams["n"] = fmt.Sprintf("%v", v)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if v, ok := params.Offset.Get(); ok {
+		queryParams["offset"] = fmt.Sprintf("%v", v)
 	}
 	if lo.IsNotEmpty(params.StartDate) {
 		queryParams["startDate"] = fmt.Sprintf("%v", params.StartDate)
@@ -3265,7 +3579,7 @@ func (c *Client) GetGroupAuditLogs(params GetGroupAuditLogsParams) (*GroupAuditL
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetGroupAuditLogs", "/groups/{groupId}/auditLogs")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -3273,39 +3587,43 @@ func (c *Client) GetGroupAuditLogs(params GetGroupAuditLogsParams) (*GroupAuditL
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetGroupAuditLogs", resp)
 	}
 	return &result, nil
 }
 
 // GetGroupBansParams represents the parameters for the GetGroupBans request
 type GetGroupBansParams struct {
-	GroupId string `json:"groupId"`
-	N       int64  `json:"n"`
-	Offset  int64  `json:"offset"`
+	GroupId string          `json:"groupId"`
+	N       Optional[int64] `json:"n"`
+	Offset  Optional[int64] `json:"offset"`
 }
 
 func (c *Client) GetGroupBans(params GetGroupBansParams) (*GroupMemberListResponse, error) {
+	return c.GetGroupBansWithContext(context.Background(), params)
+}
+
+func (c *Client) GetGroupBansWithContext(ctx context.Context, params GetGroupBansParams) (*GroupMemberListResponse, error) {
 	path := "/groups/{groupId}/bans"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if v, ok := params.N.Get(); ok {
+		queryParams["n"] = fmt.Sprintf("%v", v)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if v, ok := params.Offset.Get(); ok {
+		queryParams["offset"] = fmt.Sprintf("%v", v)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetGroupBans", "/groups/{groupId}/bans")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -3313,14 +3631,14 @@ func (c *Client) GetGroupBans(params GetGroupBansParams) (*GroupMemberListRespon
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetGroupBans", resp)
 	}
 	return &result, nil
 }
@@ -3331,13 +3649,17 @@ type BanGroupMemberParams struct {
 }
 
 func (c *Client) BanGroupMember(params BanGroupMemberParams, body BanGroupMemberRequest) (*GroupMemberResponse, error) {
+	return c.BanGroupMemberWithContext(context.Background(), params, body)
+}
+
+func (c *Client) BanGroupMemberWithContext(ctx context.Context, params BanGroupMemberParams, body BanGroupMemberRequest) (*GroupMemberResponse, error) {
 	path := "/groups/{groupId}/bans"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "BanGroupMember", "/groups/{groupId}/bans")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set request body
@@ -3347,14 +3669,14 @@ func (c *Client) BanGroupMember(params BanGroupMemberParams, body BanGroupMember
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodPost, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("BanGroupMember", resp)
 	}
 	return &result, nil
 }
@@ -3366,6 +3688,10 @@ type UnbanGroupMemberParams struct {
 }
 
 func (c *Client) UnbanGroupMember(params UnbanGroupMemberParams) (*GroupMemberResponse, error) {
+	return c.UnbanGroupMemberWithContext(context.Background(), params)
+}
+
+func (c *Client) UnbanGroupMemberWithContext(ctx context.Context, params UnbanGroupMemberParams) (*GroupMemberResponse, error) {
 	path := "/groups/{groupId}/bans/{userId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -3373,7 +3699,7 @@ func (c *Client) UnbanGroupMember(params UnbanGroupMemberParams) (*GroupMemberRe
 	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "UnbanGroupMember", "/groups/{groupId}/bans/{userId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -3381,14 +3707,14 @@ func (c *Client) UnbanGroupMember(params UnbanGroupMemberParams) (*GroupMemberRe
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Delete(path)
+	resp, err := c.send(req, resty.MethodDelete, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("UnbanGroupMember", resp)
 	}
 	return &result, nil
 }
@@ -3399,13 +3725,17 @@ type CreateGroupGalleryParams struct {
 }
 
 func (c *Client) CreateGroupGallery(params CreateGroupGalleryParams, body CreateGroupGalleryRequest) (*GroupGalleryResponse, error) {
+	return c.CreateGroupGalleryWithContext(context.Background(), params, body)
+}
+
+func (c *Client) CreateGroupGalleryWithContext(ctx context.Context, params CreateGroupGalleryParams, body CreateGroupGalleryRequest) (*GroupGalleryResponse, error) {
 	path := "/groups/{groupId}/galleries"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "CreateGroupGallery", "/groups/{groupId}/galleries")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set request body
@@ -3415,14 +3745,14 @@ func (c *Client) CreateGroupGallery(params CreateGroupGalleryParams, body Create
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodPost, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("CreateGroupGallery", resp)
 	}
 	return &result, nil
 }
@@ -3434,6 +3764,10 @@ type DeleteGroupGalleryParams struct {
 }
 
 func (c *Client) DeleteGroupGallery(params DeleteGroupGalleryParams) (*DeleteGroupGallerySuccess, error) {
+	return c.DeleteGroupGalleryWithContext(context.Background(), params)
+}
+
+func (c *Client) DeleteGroupGalleryWithContext(ctx context.Context, params DeleteGroupGalleryParams) (*DeleteGroupGallerySuccess, error) {
 	path := "/groups/{groupId}/galleries/{groupGalleryId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -3441,7 +3775,7 @@ func (c *Client) DeleteGroupGallery(params DeleteGroupGalleryParams) (*DeleteGro
 	path = strings.ReplaceAll(path, "{groupGalleryId}", fmt.Sprintf("%v", params.GroupGalleryId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "DeleteGroupGallery", "/groups/{groupId}/galleries/{groupGalleryId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -3449,41 +3783,45 @@ func (c *Client) DeleteGroupGallery(params DeleteGroupGalleryParams) (*DeleteGro
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Delete(path)
+	resp, err := c.send(req, resty.MethodDelete, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("DeleteGroupGallery", resp)
 	}
 	return &result, nil
 }
 
 // GetGroupGalleryImagesParams represents the parameters for the GetGroupGalleryImages request
 type GetGroupGalleryImagesParams struct {
-	GroupId        string `json:"groupId"`
-	GroupGalleryId string `json:"groupGalleryId"`
-	N              int64  `json:"n"`
-	Offset         int64  `json:"offset"`
+	GroupId        string          `json:"groupId"`
+	GroupGalleryId string          `json:"groupGalleryId"`
+	N              Optional[int64] `json:"n"`
+	Offset         Optional[int64] `json:"offset"`
 }
 
 func (c *Client) GetGroupGalleryImages(params GetGroupGalleryImagesParams) (*GroupGalleryImageListResponse, error) {
+	return c.GetGroupGalleryImagesWithContext(context.Background(), params)
+}
+
+func (c *Client) GetGroupGalleryImagesWithContext(ctx context.Context, params GetGroupGalleryImagesParams) (*GroupGalleryImageListResponse, error) {
 	path := "/groups/{groupId}/galleries/{groupGalleryId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
 	path = strings.ReplaceAll(path, "{groupGalleryId}", fmt.Sprintf("%v", params.GroupGalleryId))
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if v, ok := params.N.Get(); ok {
+		queryParams["n"] = fmt.Sprintf("%v", v)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if v, ok := params.Offset.Get(); ok {
+		queryParams["offset"] = fmt.Sprintf("%v", v)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetGroupGalleryImages", "/groups/{groupId}/galleries/{groupGalleryId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -3491,14 +3829,14 @@ func (c *Client) GetGroupGalleryImages(params GetGroupGalleryImagesParams) (*Gro
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetGroupGalleryImages", resp)
 	}
 	return &result, nil
 }
@@ -3510,6 +3848,10 @@ type UpdateGroupGalleryParams struct {
 }
 
 func (c *Client) UpdateGroupGallery(params UpdateGroupGalleryParams, body UpdateGroupGalleryRequest) (*GroupGalleryResponse, error) {
+	return c.UpdateGroupGalleryWithContext(context.Background(), params, body)
+}
+
+func (c *Client) UpdateGroupGalleryWithContext(ctx context.Context, params UpdateGroupGalleryParams, body UpdateGroupGalleryRequest) (*GroupGalleryResponse, error) {
 	path := "/groups/{groupId}/galleries/{groupGalleryId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -3517,7 +3859,7 @@ func (c *Client) UpdateGroupGallery(params UpdateGroupGalleryParams, body Update
 	path = strings.ReplaceAll(path, "{groupGalleryId}", fmt.Sprintf("%v", params.GroupGalleryId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "UpdateGroupGallery", "/groups/{groupId}/galleries/{groupGalleryId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set request body
@@ -3527,14 +3869,14 @@ func (c *Client) UpdateGroupGallery(params UpdateGroupGalleryParams, body Update
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Put(path)
+	resp, err := c.send(req, resty.MethodPut, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("UpdateGroupGallery", resp)
 	}
 	return &result, nil
 }
@@ -3546,6 +3888,10 @@ type AddGroupGalleryImageParams struct {
 }
 
 func (c *Client) AddGroupGalleryImage(params AddGroupGalleryImageParams, body AddGroupGalleryImageRequest) (*GroupGalleryImageResponse, error) {
+	return c.AddGroupGalleryImageWithContext(context.Background(), params, body)
+}
+
+func (c *Client) AddGroupGalleryImageWithContext(ctx context.Context, params AddGroupGalleryImageParams, body AddGroupGalleryImageRequest) (*GroupGalleryImageResponse, error) {
 	path := "/groups/{groupId}/galleries/{groupGalleryId}/images"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -3553,7 +3899,7 @@ func (c *Client) AddGroupGalleryImage(params AddGroupGalleryImageParams, body Ad
 	path = strings.ReplaceAll(path, "{groupGalleryId}", fmt.Sprintf("%v", params.GroupGalleryId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "AddGroupGalleryImage", "/groups/{groupId}/galleries/{groupGalleryId}/images")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set request body
@@ -3563,14 +3909,14 @@ func (c *Client) AddGroupGalleryImage(params AddGroupGalleryImageParams, body Ad
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodPost, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("AddGroupGalleryImage", resp)
 	}
 	return &result, nil
 }
@@ -3583,6 +3929,10 @@ type DeleteGroupGalleryImageParams struct {
 }
 
 func (c *Client) DeleteGroupGalleryImage(params DeleteGroupGalleryImageParams) (*DeleteGroupGalleryImageSuccess, error) {
+	return c.DeleteGroupGalleryImageWithContext(context.Background(), params)
+}
+
+func (c *Client) DeleteGroupGalleryImageWithContext(ctx context.Context, params DeleteGroupGalleryImageParams) (*DeleteGroupGalleryImageSuccess, error) {
 	path := "/groups/{groupId}/galleries/{groupGalleryId}/images/{groupGalleryImageId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -3591,7 +3941,7 @@ func (c *Client) DeleteGroupGalleryImage(params DeleteGroupGalleryImageParams) (
 	path = strings.ReplaceAll(path, "{groupGalleryImageId}", fmt.Sprintf("%v", params.GroupGalleryImageId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "DeleteGroupGalleryImage", "/groups/{groupId}/galleries/{groupGalleryId}/images/{groupGalleryImageId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -3599,14 +3949,14 @@ func (c *Client) DeleteGroupGalleryImage(params DeleteGroupGalleryImageParams) (
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Delete(path)
+	resp, err := c.send(req, resty.MethodDelete, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("DeleteGroupGalleryImage", resp)
 	}
 	return &result, nil
 }
@@ -3617,13 +3967,17 @@ type GetGroupInstancesParams struct {
 }
 
 func (c *Client) GetGroupInstances(params GetGroupInstancesParams) (*GroupInstanceListResponse, error) {
+	return c.GetGroupInstancesWithContext(context.Background(), params)
+}
+
+func (c *Client) GetGroupInstancesWithContext(ctx context.Context, params GetGroupInstancesParams) (*GroupInstanceListResponse, error) {
 	path := "/groups/{groupId}/instances"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetGroupInstances", "/groups/{groupId}/instances")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -3631,39 +3985,43 @@ func (c *Client) GetGroupInstances(params GetGroupInstancesParams) (*GroupInstan
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetGroupInstances", resp)
 	}
 	return &result, nil
 }
 
 // GetGroupInvitesParams represents the parameters for the GetGroupInvites request
 type GetGroupInvitesParams struct {
-	GroupId string `json:"groupId"`
-	N       int64  `json:"n"`
-	Offset  int64  `json:"offset"`
+	GroupId string          `json:"groupId"`
+	N       Optional[int64] `json:"n"`
+	Offset  Optional[int64] `json:"offset"`
 }
 
 func (c *Client) GetGroupInvites(params GetGroupInvitesParams) (*GroupMemberListResponse, error) {
+	return c.GetGroupInvitesWithContext(context.Background(), params)
+}
+
+func (c *Client) GetGroupInvitesWithContext(ctx context.Context, params GetGroupInvitesParams) (*GroupMemberListResponse, error) {
 	path := "/groups/{groupId}/invites"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if v, ok := params.N.Get(); ok {
+		queryParams["n"] = fmt.Sprintf("%v", v)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if v, ok := params.Offset.Get(); ok {
+		queryParams["offset"] = fmt.Sprintf("%v", v)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetGroupInvites", "/groups/{groupId}/invites")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -3671,14 +4029,14 @@ func (c *Client) GetGroupInvites(params GetGroupInvitesParams) (*GroupMemberList
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetGroupInvites", resp)
 	}
 	return &result, nil
 }
@@ -3689,27 +4047,31 @@ type CreateGroupInviteParams struct {
 }
 
 func (c *Client) CreateGroupInvite(params CreateGroupInviteParams, body CreateGroupInviteRequest) error {
+	return c.CreateGroupInviteWithContext(context.Background(), params, body)
+}
+
+func (c *Client) CreateGroupInviteWithContext(ctx context.Context, params CreateGroupInviteParams, body CreateGroupInviteRequest) error {
 	path := "/groups/{groupId}/invites"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "CreateGroupInvite", "/groups/{groupId}/invites")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set request body
 	req.SetBody(body)
 
 	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodPost, path)
 	if err != nil {
 		return fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return newAPIError("CreateGroupInvite", resp)
 	}
 	return nil
 }
@@ -3721,6 +4083,10 @@ type DeleteGroupInviteParams struct {
 }
 
 func (c *Client) DeleteGroupInvite(params DeleteGroupInviteParams) error {
+	return c.DeleteGroupInviteWithContext(context.Background(), params)
+}
+
+func (c *Client) DeleteGroupInviteWithContext(ctx context.Context, params DeleteGroupInviteParams) error {
 	path := "/groups/{groupId}/invites/{userId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -3728,19 +4094,19 @@ func (c *Client) DeleteGroupInvite(params DeleteGroupInviteParams) error {
 	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "DeleteGroupInvite", "/groups/{groupId}/invites/{userId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 
 	// Send request
-	resp, err := req.Delete(path)
+	resp, err := c.send(req, resty.MethodDelete, path)
 	if err != nil {
 		return fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return newAPIError("DeleteGroupInvite", resp)
 	}
 	return nil
 }
@@ -3751,13 +4117,17 @@ type JoinGroupParams struct {
 }
 
 func (c *Client) JoinGroup(params JoinGroupParams) (*GroupMemberResponse, error) {
+	return c.JoinGroupWithContext(context.Background(), params)
+}
+
+func (c *Client) JoinGroupWithContext(ctx context.Context, params JoinGroupParams) (*GroupMemberResponse, error) {
 	path := "/groups/{groupId}/join"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "JoinGroup", "/groups/{groupId}/join")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -3765,14 +4135,14 @@ func (c *Client) JoinGroup(params JoinGroupParams) (*GroupMemberResponse, error)
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodPost, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("JoinGroup", resp)
 	}
 	return &result, nil
 }
@@ -3783,25 +4153,29 @@ type LeaveGroupParams struct {
 }
 
 func (c *Client) LeaveGroup(params LeaveGroupParams) error {
+	return c.LeaveGroupWithContext(context.Background(), params)
+}
+
+func (c *Client) LeaveGroupWithContext(ctx context.Context, params LeaveGroupParams) error {
 	path := "/groups/{groupId}/leave"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "LeaveGroup", "/groups/{groupId}/leave")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 
 	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodPost, path)
 	if err != nil {
 		return fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return newAPIError("LeaveGroup", resp)
 	}
 	return nil
 }
@@ -3809,22 +4183,26 @@ func (c *Client) LeaveGroup(params LeaveGroupParams) error {
 // GetGroupMembersParams represents the parameters for the GetGroupMembers request
 type GetGroupMembersParams struct {
 	GroupId string          `json:"groupId"`
-	N       int64           `json:"n"`
-	Offset  int64           `json:"offset"`
+	N       Optional[int64] `json:"n"`
+	Offset  Optional[int64] `json:"offset"`
 	Sort    GroupSearchSort `json:"sort"`
 	RoleId  GroupRoleId     `json:"roleId"`
 }
 
 func (c *Client) GetGroupMembers(params GetGroupMembersParams) (*GroupMemberListResponse, error) {
+	return c.GetGroupMembersWithContext(context.Background(), params)
+}
+
+func (c *Client) GetGroupMembersWithContext(ctx context.Context, params GetGroupMembersParams) (*GroupMemberListResponse, error) {
 	path := "/groups/{groupId}/members"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if v, ok := params.N.Get(); ok {
+		queryParams["n"] = fmt.Sprintf("%v", v)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if v, ok := params.Offset.Get(); ok {
+		queryParams["offset"] = fmt.Sprintf("%v", v)
 	}
 	if lo.IsNotEmpty(params.Sort) {
 		queryParams["sort"] = fmt.Sprintf("%v", params.Sort)
@@ -3834,7 +4212,7 @@ func (c *Client) GetGroupMembers(params GetGroupMembersParams) (*GroupMemberList
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetGroupMembers", "/groups/{groupId}/members")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -3842,14 +4220,14 @@ func (c *Client) GetGroupMembers(params GetGroupMembersParams) (*GroupMemberList
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetGroupMembers", resp)
 	}
 	return &result, nil
 }
@@ -3861,6 +4239,10 @@ type KickGroupMemberParams struct {
 }
 
 func (c *Client) KickGroupMember(params KickGroupMemberParams) error {
+	return c.KickGroupMemberWithContext(context.Background(), params)
+}
+
+func (c *Client) KickGroupMemberWithContext(ctx context.Context, params KickGroupMemberParams) error {
 	path := "/groups/{groupId}/members/{userId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -3868,19 +4250,19 @@ func (c *Client) KickGroupMember(params KickGroupMemberParams) error {
 	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "KickGroupMember", "/groups/{groupId}/members/{userId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 
 	// Send request
-	resp, err := req.Delete(path)
+	resp, err := c.send(req, resty.MethodDelete, path)
 	if err != nil {
 		return fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return newAPIError("KickGroupMember", resp)
 	}
 	return nil
 }
@@ -3892,6 +4274,10 @@ type GetGroupMemberParams struct {
 }
 
 func (c *Client) GetGroupMember(params GetGroupMemberParams) (*GroupLimitedMemberResponse, error) {
+	return c.GetGroupMemberWithContext(context.Background(), params)
+}
+
+func (c *Client) GetGroupMemberWithContext(ctx context.Context, params GetGroupMemberParams) (*GroupLimitedMemberResponse, error) {
 	path := "/groups/{groupId}/members/{userId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -3899,7 +4285,7 @@ func (c *Client) GetGroupMember(params GetGroupMemberParams) (*GroupLimitedMembe
 	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetGroupMember", "/groups/{groupId}/members/{userId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -3907,14 +4293,14 @@ func (c *Client) GetGroupMember(params GetGroupMemberParams) (*GroupLimitedMembe
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetGroupMember", resp)
 	}
 	return &result, nil
 }
@@ -3926,6 +4312,10 @@ type UpdateGroupMemberParams struct {
 }
 
 func (c *Client) UpdateGroupMember(params UpdateGroupMemberParams, body UpdateGroupMemberRequest) (*GroupLimitedMemberResponse, error) {
+	return c.UpdateGroupMemberWithContext(context.Background(), params, body)
+}
+
+func (c *Client) UpdateGroupMemberWithContext(ctx context.Context, params UpdateGroupMemberParams, body UpdateGroupMemberRequest) (*GroupLimitedMemberResponse, error) {
 	path := "/groups/{groupId}/members/{userId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -3933,7 +4323,7 @@ func (c *Client) UpdateGroupMember(params UpdateGroupMemberParams, body UpdateGr
 	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "UpdateGroupMember", "/groups/{groupId}/members/{userId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set request body
@@ -3943,14 +4333,14 @@ func (c *Client) UpdateGroupMember(params UpdateGroupMemberParams, body UpdateGr
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Put(path)
+	resp, err := c.send(req, resty.MethodPut, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("UpdateGroupMember", resp)
 	}
 	return &result, nil
 }
@@ -3963,6 +4353,10 @@ type RemoveGroupMemberRoleParams struct {
 }
 
 func (c *Client) RemoveGroupMemberRole(params RemoveGroupMemberRoleParams) (*GroupRoleIdListResponse, error) {
+	return c.RemoveGroupMemberRoleWithContext(context.Background(), params)
+}
+
+func (c *Client) RemoveGroupMemberRoleWithContext(ctx context.Context, params RemoveGroupMemberRoleParams) (*GroupRoleIdListResponse, error) {
 	path := "/groups/{groupId}/members/{userId}/roles/{groupRoleId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -3971,7 +4365,7 @@ func (c *Client) RemoveGroupMemberRole(params RemoveGroupMemberRoleParams) (*Gro
 	path = strings.ReplaceAll(path, "{groupRoleId}", fmt.Sprintf("%v", params.GroupRoleId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "RemoveGroupMemberRole", "/groups/{groupId}/members/{userId}/roles/{groupRoleId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -3979,14 +4373,14 @@ func (c *Client) RemoveGroupMemberRole(params RemoveGroupMemberRoleParams) (*Gro
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Delete(path)
+	resp, err := c.send(req, resty.MethodDelete, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("RemoveGroupMemberRole", resp)
 	}
 	return &result, nil
 }
@@ -3999,6 +4393,10 @@ type AddGroupMemberRoleParams struct {
 }
 
 func (c *Client) AddGroupMemberRole(params AddGroupMemberRoleParams) (*GroupRoleIdListResponse, error) {
+	return c.AddGroupMemberRoleWithContext(context.Background(), params)
+}
+
+func (c *Client) AddGroupMemberRoleWithContext(ctx context.Context, params AddGroupMemberRoleParams) (*GroupRoleIdListResponse, error) {
 	path := "/groups/{groupId}/members/{userId}/roles/{groupRoleId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -4007,7 +4405,7 @@ func (c *Client) AddGroupMemberRole(params AddGroupMemberRoleParams) (*GroupRole
 	path = strings.ReplaceAll(path, "{groupRoleId}", fmt.Sprintf("%v", params.GroupRoleId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "AddGroupMemberRole", "/groups/{groupId}/members/{userId}/roles/{groupRoleId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -4015,14 +4413,14 @@ func (c *Client) AddGroupMemberRole(params AddGroupMemberRoleParams) (*GroupRole
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Put(path)
+	resp, err := c.send(req, resty.MethodPut, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("AddGroupMemberRole", resp)
 	}
 	return &result, nil
 }
@@ -4033,13 +4431,17 @@ type GetGroupPermissionsParams struct {
 }
 
 func (c *Client) GetGroupPermissions(params GetGroupPermissionsParams) (*GroupPermissionListResponse, error) {
+	return c.GetGroupPermissionsWithContext(context.Background(), params)
+}
+
+func (c *Client) GetGroupPermissionsWithContext(ctx context.Context, params GetGroupPermissionsParams) (*GroupPermissionListResponse, error) {
 	path := "/groups/{groupId}/permissions"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetGroupPermissions", "/groups/{groupId}/permissions")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -4047,39 +4449,43 @@ func (c *Client) GetGroupPermissions(params GetGroupPermissionsParams) (*GroupPe
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetGroupPermissions", resp)
 	}
 	return &result, nil
 }
 
 // GetGroupPostsParams represents the parameters for the GetGroupPosts request
 type GetGroupPostsParams struct {
-	GroupId string `json:"groupId"`
-	N       int64  `json:"n"`
-	Offset  int64  `json:"offset"`
+	GroupId string          `json:"groupId"`
+	N       Optional[int64] `json:"n"`
+	Offset  Optional[int64] `json:"offset"`
 }
 
 func (c *Client) GetGroupPosts(params GetGroupPostsParams) (*GroupPostsResponse, error) {
+	return c.GetGroupPostsWithContext(context.Background(), params)
+}
+
+func (c *Client) GetGroupPostsWithContext(ctx context.Context, params GetGroupPostsParams) (*GroupPostsResponse, error) {
 	path := "/groups/{groupId}/posts"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if v, ok := params.N.Get(); ok {
+		queryParams["n"] = fmt.Sprintf("%v", v)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if v, ok := params.Offset.Get(); ok {
+		queryParams["offset"] = fmt.Sprintf("%v", v)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetGroupPosts", "/groups/{groupId}/posts")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -4087,14 +4493,14 @@ func (c *Client) GetGroupPosts(params GetGroupPostsParams) (*GroupPostsResponse,
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetGroupPosts", resp)
 	}
 	return &result, nil
 }
@@ -4105,13 +4511,17 @@ type AddGroupPostParams struct {
 }
 
 func (c *Client) AddGroupPost(params AddGroupPostParams, body CreateGroupPostRequest) (*GroupPostResponse, error) {
+	return c.AddGroupPostWithContext(context.Background(), params, body)
+}
+
+func (c *Client) AddGroupPostWithContext(ctx context.Context, params AddGroupPostParams, body CreateGroupPostRequest) (*GroupPostResponse, error) {
 	path := "/groups/{groupId}/posts"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "AddGroupPost", "/groups/{groupId}/posts")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set request body
@@ -4121,14 +4531,14 @@ func (c *Client) AddGroupPost(params AddGroupPostParams, body CreateGroupPostReq
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodPost, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("AddGroupPost", resp)
 	}
 	return &result, nil
 }
@@ -4140,6 +4550,10 @@ type DeleteGroupPostParams struct {
 }
 
 func (c *Client) DeleteGroupPost(params DeleteGroupPostParams) (*GroupPostResponseSuccess, error) {
+	return c.DeleteGroupPostWithContext(context.Background(), params)
+}
+
+func (c *Client) DeleteGroupPostWithContext(ctx context.Context, params DeleteGroupPostParams) (*GroupPostResponseSuccess, error) {
 	path := "/groups/{groupId}/posts/{notificationId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -4147,7 +4561,7 @@ func (c *Client) DeleteGroupPost(params DeleteGroupPostParams) (*GroupPostRespon
 	path = strings.ReplaceAll(path, "{notificationId}", fmt.Sprintf("%v", params.NotificationId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "DeleteGroupPost", "/groups/{groupId}/posts/{notificationId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -4155,14 +4569,14 @@ func (c *Client) DeleteGroupPost(params DeleteGroupPostParams) (*GroupPostRespon
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Delete(path)
+	resp, err := c.send(req, resty.MethodDelete, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("DeleteGroupPost", resp)
 	}
 	return &result, nil
 }
@@ -4174,6 +4588,10 @@ type UpdateGroupPostParams struct {
 }
 
 func (c *Client) UpdateGroupPost(params UpdateGroupPostParams, body CreateGroupPostRequest) (*GroupPostResponse, error) {
+	return c.UpdateGroupPostWithContext(context.Background(), params, body)
+}
+
+func (c *Client) UpdateGroupPostWithContext(ctx context.Context, params UpdateGroupPostParams, body CreateGroupPostRequest) (*GroupPostResponse, error) {
 	path := "/groups/{groupId}/posts/{notificationId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -4181,7 +4599,7 @@ func (c *Client) UpdateGroupPost(params UpdateGroupPostParams, body CreateGroupP
 	path = strings.ReplaceAll(path, "{notificationId}", fmt.Sprintf("%v", params.NotificationId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "UpdateGroupPost", "/groups/{groupId}/posts/{notificationId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set request body
@@ -4191,14 +4609,14 @@ func (c *Client) UpdateGroupPost(params UpdateGroupPostParams, body CreateGroupP
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Put(path)
+	resp, err := c.send(req, resty.MethodPut, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("UpdateGroupPost", resp)
 	}
 	return &result, nil
 }
@@ -4209,13 +4627,17 @@ type UpdateGroupRepresentationParams struct {
 }
 
 func (c *Client) UpdateGroupRepresentation(params UpdateGroupRepresentationParams) (*UpdateGroupRepresentationSuccess, error) {
+	return c.UpdateGroupRepresentationWithContext(context.Background(), params)
+}
+
+func (c *Client) UpdateGroupRepresentationWithContext(ctx context.Context, params UpdateGroupRepresentationParams) (*UpdateGroupRepresentationSuccess, error) {
 	path := "/groups/{groupId}/representation"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "UpdateGroupRepresentation", "/groups/{groupId}/representation")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -4223,14 +4645,14 @@ func (c *Client) UpdateGroupRepresentation(params UpdateGroupRepresentationParam
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Put(path)
+	resp, err := c.send(req, resty.MethodPut, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("UpdateGroupRepresentation", resp)
 	}
 	return &result, nil
 }
@@ -4241,50 +4663,58 @@ type CancelGroupRequestParams struct {
 }
 
 func (c *Client) CancelGroupRequest(params CancelGroupRequestParams) error {
+	return c.CancelGroupRequestWithContext(context.Background(), params)
+}
+
+func (c *Client) CancelGroupRequestWithContext(ctx context.Context, params CancelGroupRequestParams) error {
 	path := "/groups/{groupId}/requests"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "CancelGroupRequest", "/groups/{groupId}/requests")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 
 	// Send request
-	resp, err := req.Delete(path)
+	resp, err := c.send(req, resty.MethodDelete, path)
 	if err != nil {
 		return fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return newAPIError("CancelGroupRequest", resp)
 	}
 	return nil
 }
 
 // GetGroupRequestsParams represents the parameters for the GetGroupRequests request
 type GetGroupRequestsParams struct {
-	GroupId string `json:"groupId"`
-	N       int64  `json:"n"`
-	Offset  int64  `json:"offset"`
+	GroupId string          `json:"groupId"`
+	N       Optional[int64] `json:"n"`
+	Offset  Optional[int64] `json:"offset"`
 }
 
 func (c *Client) GetGroupRequests(params GetGroupRequestsParams) (*GroupMemberListResponse, error) {
+	return c.GetGroupRequestsWithContext(context.Background(), params)
+}
+
+func (c *Client) GetGroupRequestsWithContext(ctx context.Context, params GetGroupRequestsParams) (*GroupMemberListResponse, error) {
 	path := "/groups/{groupId}/requests"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if v, ok := params.N.Get(); ok {
+		queryParams["n"] = fmt.Sprintf("%v", v)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if v, ok := params.Offset.Get(); ok {
+		queryParams["offset"] = fmt.Sprintf("%v", v)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetGroupRequests", "/groups/{groupId}/requests")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -4292,14 +4722,14 @@ func (c *Client) GetGroupRequests(params GetGroupRequestsParams) (*GroupMemberLi
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetGroupRequests", resp)
 	}
 	return &result, nil
 }
@@ -4311,6 +4741,10 @@ type RespondGroupJoinRequestParams struct {
 }
 
 func (c *Client) RespondGroupJoinRequest(params RespondGroupJoinRequestParams, body RespondGroupJoinRequest) error {
+	return c.RespondGroupJoinRequestWithContext(context.Background(), params, body)
+}
+
+func (c *Client) RespondGroupJoinRequestWithContext(ctx context.Context, params RespondGroupJoinRequestParams, body RespondGroupJoinRequest) error {
 	path := "/groups/{groupId}/requests/{userId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -4318,21 +4752,21 @@ func (c *Client) RespondGroupJoinRequest(params RespondGroupJoinRequestParams, b
 	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "RespondGroupJoinRequest", "/groups/{groupId}/requests/{userId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set request body
 	req.SetBody(body)
 
 	// Send request
-	resp, err := req.Put(path)
+	resp, err := c.send(req, resty.MethodPut, path)
 	if err != nil {
 		return fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return newAPIError("RespondGroupJoinRequest", resp)
 	}
 	return nil
 }
@@ -4343,13 +4777,17 @@ type GetGroupRolesParams struct {
 }
 
 func (c *Client) GetGroupRoles(params GetGroupRolesParams) (*GroupRoleListResponse, error) {
+	return c.GetGroupRolesWithContext(context.Background(), params)
+}
+
+func (c *Client) GetGroupRolesWithContext(ctx context.Context, params GetGroupRolesParams) (*GroupRoleListResponse, error) {
 	path := "/groups/{groupId}/roles"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetGroupRoles", "/groups/{groupId}/roles")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -4357,14 +4795,14 @@ func (c *Client) GetGroupRoles(params GetGroupRolesParams) (*GroupRoleListRespon
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetGroupRoles", resp)
 	}
 	return &result, nil
 }
@@ -4375,13 +4813,17 @@ type CreateGroupRoleParams struct {
 }
 
 func (c *Client) CreateGroupRole(params CreateGroupRoleParams, body CreateGroupRoleRequest) (*GroupRoleResponse, error) {
+	return c.CreateGroupRoleWithContext(context.Background(), params, body)
+}
+
+func (c *Client) CreateGroupRoleWithContext(ctx context.Context, params CreateGroupRoleParams, body CreateGroupRoleRequest) (*GroupRoleResponse, error) {
 	path := "/groups/{groupId}/roles"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "CreateGroupRole", "/groups/{groupId}/roles")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set request body
@@ -4391,14 +4833,14 @@ func (c *Client) CreateGroupRole(params CreateGroupRoleParams, body CreateGroupR
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodPost, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("CreateGroupRole", resp)
 	}
 	return &result, nil
 }
@@ -4410,6 +4852,10 @@ type DeleteGroupRoleParams struct {
 }
 
 func (c *Client) DeleteGroupRole(params DeleteGroupRoleParams) (*GroupRoleListResponse, error) {
+	return c.DeleteGroupRoleWithContext(context.Background(), params)
+}
+
+func (c *Client) DeleteGroupRoleWithContext(ctx context.Context, params DeleteGroupRoleParams) (*GroupRoleListResponse, error) {
 	path := "/groups/{groupId}/roles/{groupRoleId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -4417,7 +4863,7 @@ func (c *Client) DeleteGroupRole(params DeleteGroupRoleParams) (*GroupRoleListRe
 	path = strings.ReplaceAll(path, "{groupRoleId}", fmt.Sprintf("%v", params.GroupRoleId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "DeleteGroupRole", "/groups/{groupId}/roles/{groupRoleId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -4425,14 +4871,14 @@ func (c *Client) DeleteGroupRole(params DeleteGroupRoleParams) (*GroupRoleListRe
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Delete(path)
+	resp, err := c.send(req, resty.MethodDelete, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("DeleteGroupRole", resp)
 	}
 	return &result, nil
 }
@@ -4444,6 +4890,10 @@ type UpdateGroupRoleParams struct {
 }
 
 func (c *Client) UpdateGroupRole(params UpdateGroupRoleParams, body UpdateGroupRoleRequest) (*GroupRoleListResponse, error) {
+	return c.UpdateGroupRoleWithContext(context.Background(), params, body)
+}
+
+func (c *Client) UpdateGroupRoleWithContext(ctx context.Context, params UpdateGroupRoleParams, body UpdateGroupRoleRequest) (*GroupRoleListResponse, error) {
 	path := "/groups/{groupId}/roles/{groupRoleId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -4451,7 +4901,7 @@ func (c *Client) UpdateGroupRole(params UpdateGroupRoleParams, body UpdateGroupR
 	path = strings.ReplaceAll(path, "{groupRoleId}", fmt.Sprintf("%v", params.GroupRoleId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "UpdateGroupRole", "/groups/{groupId}/roles/{groupRoleId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set request body
@@ -4461,22 +4911,22 @@ func (c *Client) UpdateGroupRole(params UpdateGroupRoleParams, body UpdateGroupR
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Put(path)
+	resp, err := c.send(req, resty.MethodPut, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("UpdateGroupRole", resp)
 	}
 	return &result, nil
 }
 
 // GetInventoryParams represents the parameters for the GetInventory request
 type GetInventoryParams struct {
-	N      int64 `json:"n"`
-	Offset int64 `json:"offset"`
+	N      Optional[int64] `json:"n"`
+	Offset Optional[int64] `json:"offset"`
 	// Order enum
 	Order string `json:"order"` // Tags Tags are a way to grant various access, assign restrictions or other kinds of metadata to various to objects such as worlds, users and avatars.
 	//
@@ -4486,18 +4936,22 @@ type GetInventoryParams struct {
 	Flags    InventoryFlag     `json:"flags"`
 	NotTypes InventoryItemType `json:"notTypes"`
 	NotFlags InventoryFlag     `json:"notFlags"`
-	Archived bool              `json:"archived"`
+	Archived Optional[bool]    `json:"archived"`
 }
 
 func (c *Client) GetInventory(params GetInventoryParams) (*InventoryResponse, error) {
+	return c.GetInventoryWithContext(context.Background(), params)
+}
+
+func (c *Client) GetInventoryWithContext(ctx context.Context, params GetInventoryParams) (*InventoryResponse, error) {
 	path := "/inventory"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if v, ok := params.N.Get(); ok {
+		queryParams["n"] = fmt.Sprintf("%v", v)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if v, ok := params.Offset.Get(); ok {
+		queryParams["offset"] = fmt.Sprintf("%v", v)
 	}
 	if lo.IsNotEmpty(params.Order) {
 		queryParams["order"] = fmt.Sprintf("%v", params.Order)
@@ -4517,12 +4971,12 @@ func (c *Client) GetInventory(params GetInventoryParams) (*InventoryResponse, er
 	if lo.IsNotEmpty(params.NotFlags) {
 		queryParams["notFlags"] = fmt.Sprintf("%v", params.NotFlags)
 	}
-	if lo.IsNotEmpty(params.Archived) {
-		queryParams["archived"] = fmt.Sprintf("%v", params.Archived)
+	if v, ok := params.Archived.Get(); ok {
+		queryParams["archived"] = fmt.Sprintf("%v", v)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetInventory", "/inventory")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -4530,14 +4984,14 @@ func (c *Client) GetInventory(params GetInventoryParams) (*InventoryResponse, er
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetInventory", resp)
 	}
 	return &result, nil
 }
@@ -4548,13 +5002,17 @@ type GetOwnInventoryItemParams struct {
 }
 
 func (c *Client) GetOwnInventoryItem(params GetOwnInventoryItemParams) (*InventoryItemResponse, error) {
+	return c.GetOwnInventoryItemWithContext(context.Background(), params)
+}
+
+func (c *Client) GetOwnInventoryItemWithContext(ctx context.Context, params GetOwnInventoryItemParams) (*InventoryItemResponse, error) {
 	path := "/inventory/{inventoryItemId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{inventoryItemId}", fmt.Sprintf("%v", params.InventoryItemId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetOwnInventoryItem", "/inventory/{inventoryItemId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -4562,14 +5020,14 @@ func (c *Client) GetOwnInventoryItem(params GetOwnInventoryItemParams) (*Invento
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetOwnInventoryItem", resp)
 	}
 	return &result, nil
 }
@@ -4580,13 +5038,17 @@ type UpdateOwnInventoryItemParams struct {
 }
 
 func (c *Client) UpdateOwnInventoryItem(params UpdateOwnInventoryItemParams, body UpdateInventoryItemRequest) (*InventoryItemResponse, error) {
+	return c.UpdateOwnInventoryItemWithContext(context.Background(), params, body)
+}
+
+func (c *Client) UpdateOwnInventoryItemWithContext(ctx context.Context, params UpdateOwnInventoryItemParams, body UpdateInventoryItemRequest) (*InventoryItemResponse, error) {
 	path := "/inventory/{inventoryItemId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{inventoryItemId}", fmt.Sprintf("%v", params.InventoryItemId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "UpdateOwnInventoryItem", "/inventory/{inventoryItemId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set request body
@@ -4596,33 +5058,37 @@ func (c *Client) UpdateOwnInventoryItem(params UpdateOwnInventoryItemParams, bod
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Put(path)
+	resp, err := c.send(req, resty.MethodPut, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("UpdateOwnInventoryItem", resp)
 	}
 	return &result, nil
 }
 
 // GetInventoryDropsParams represents the parameters for the GetInventoryDrops request
 type GetInventoryDropsParams struct {
-	Active bool `json:"active"`
+	Active Optional[bool] `json:"active"`
 }
 
 func (c *Client) GetInventoryDrops(params GetInventoryDropsParams) (*InventoryDropListResponse, error) {
+	return c.GetInventoryDropsWithContext(context.Background(), params)
+}
+
+func (c *Client) GetInventoryDropsWithContext(ctx context.Context, params GetInventoryDropsParams) (*InventoryDropListResponse, error) {
 	path := "/inventory/drops"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	if lo.IsNotEmpty(params.Active) {
-		queryParams["active"] = fmt.Sprintf("%v", params.Active)
+	if v, ok := params.Active.Get(); ok {
+		queryParams["active"] = fmt.Sprintf("%v", v)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetInventoryDrops", "/inventory/drops")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -4630,14 +5096,14 @@ func (c *Client) GetInventoryDrops(params GetInventoryDropsParams) (*InventoryDr
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetInventoryDrops", resp)
 	}
 	return &result, nil
 }
@@ -4648,13 +5114,17 @@ type GetInventoryTemplateParams struct {
 }
 
 func (c *Client) GetInventoryTemplate(params GetInventoryTemplateParams) (*InventoryTemplateResponse, error) {
+	return c.GetInventoryTemplateWithContext(context.Background(), params)
+}
+
+func (c *Client) GetInventoryTemplateWithContext(ctx context.Context, params GetInventoryTemplateParams) (*InventoryTemplateResponse, error) {
 	path := "/inventory/template/{inventoryTemplateId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{inventoryTemplateId}", fmt.Sprintf("%v", params.InventoryTemplateId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetInventoryTemplate", "/inventory/template/{inventoryTemplateId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -4662,14 +5132,14 @@ func (c *Client) GetInventoryTemplate(params GetInventoryTemplateParams) (*Inven
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetInventoryTemplate", resp)
 	}
 	return &result, nil
 }
@@ -4680,6 +5150,10 @@ type SpawnInventoryItemParams struct {
 }
 
 func (c *Client) SpawnInventoryItem(params SpawnInventoryItemParams) (*InventorySpawnResponse, error) {
+	return c.SpawnInventoryItemWithContext(context.Background(), params)
+}
+
+func (c *Client) SpawnInventoryItemWithContext(ctx context.Context, params SpawnInventoryItemParams) (*InventorySpawnResponse, error) {
 	path := "/inventory/spawn"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -4688,7 +5162,7 @@ func (c *Client) SpawnInventoryItem(params SpawnInventoryItemParams) (*Inventory
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "SpawnInventoryItem", "/inventory/spawn")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -4696,14 +5170,14 @@ func (c *Client) SpawnInventoryItem(params SpawnInventoryItemParams) (*Inventory
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("SpawnInventoryItem", resp)
 	}
 	return &result, nil
 }
@@ -4715,18 +5189,20 @@ type ShareInventoryItemPedestalParams struct {
 }
 
 func (c *Client) ShareInventoryItemPedestal(params ShareInventoryItemPedestalParams) (*InventorySpawnResponse, error) {
+	return c.ShareInventoryItemPedestalWithContext(context.Background(), params)
+}
+
+func (c *Client) ShareInventoryItemPedestalWithContext(ctx context.Context, params ShareInventoryItemPedestalParams) (*InventorySpawnResponse, error) {
 	path := "/inventory/cloning/pedestal"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	if lo.IsNotEmpty(params.ItemId) {
 		queryParams["itemId"] = fmt.Sprintf("%v", params.ItemId)
 	}
-	if lo.IsNotEmpty(params.Duration) {
-		queryParams["duration"] = fmt.Sprintf("%v", params.Duration)
-	}
+	queryParams["duration"] = fmt.Sprintf("%v", params.Duration)
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "ShareInventoryItemPedestal", "/inventory/cloning/pedestal")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -4734,14 +5210,14 @@ func (c *Client) ShareInventoryItemPedestal(params ShareInventoryItemPedestalPar
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("ShareInventoryItemPedestal", resp)
 	}
 	return &result, nil
 }
@@ -4753,18 +5229,20 @@ type ShareInventoryItemDirectParams struct {
 }
 
 func (c *Client) ShareInventoryItemDirect(params ShareInventoryItemDirectParams, body ShareInventoryItemDirectRequest) (*InventoryShareResponse, error) {
+	return c.ShareInventoryItemDirectWithContext(context.Background(), params, body)
+}
+
+func (c *Client) ShareInventoryItemDirectWithContext(ctx context.Context, params ShareInventoryItemDirectParams, body ShareInventoryItemDirectRequest) (*InventoryShareResponse, error) {
 	path := "/inventory/cloning/direct"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	if lo.IsNotEmpty(params.ItemId) {
 		queryParams["itemId"] = fmt.Sprintf("%v", params.ItemId)
 	}
-	if lo.IsNotEmpty(params.Duration) {
-		queryParams["duration"] = fmt.Sprintf("%v", params.Duration)
-	}
+	queryParams["duration"] = fmt.Sprintf("%v", params.Duration)
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "ShareInventoryItemDirect", "/inventory/cloning/direct")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set request body
@@ -4774,14 +5252,14 @@ func (c *Client) ShareInventoryItemDirect(params ShareInventoryItemDirectParams,
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodPost, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("ShareInventoryItemDirect", resp)
 	}
 	return &result, nil
 }
@@ -4792,13 +5270,17 @@ type InviteUserParams struct { // UserId A users unique ID, usually in the form
 }
 
 func (c *Client) InviteUser(params InviteUserParams, body InviteRequest) (*SendNotificationResponse, error) {
+	return c.InviteUserWithContext(context.Background(), params, body)
+}
+
+func (c *Client) InviteUserWithContext(ctx context.Context, params InviteUserParams, body InviteRequest) (*SendNotificationResponse, error) {
 	path := "/invite/{userId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "InviteUser", "/invite/{userId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set request body
@@ -4808,14 +5290,14 @@ func (c *Client) InviteUser(params InviteUserParams, body InviteRequest) (*SendN
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodPost, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("InviteUser", resp)
 	}
 	return &result, nil
 }
@@ -4825,33 +5307,6 @@ type InviteUserWithPhotoParams struct { // UserId A users unique ID, usually in
 	UserId UserId `json:"userId"`
 }
 
-func (c *Client) InviteUserWithPhoto(params InviteUserWithPhotoParams) (*SendNotificationResponse, error) {
-	path := "/invite/{userId}/photo"
-	// Replace path parameters and prepare query parameters
-	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
-
-	// Create request
-	req := c.client.R()
-	// Set query parameters
-	req.SetQueryParams(queryParams)
-	// Set response object
-	var result SendNotificationResponse
-	req.SetResult(&result)
-
-	// Send request
-	resp, err := req.Post(path)
-	if err != nil {
-		return nil, fmt.Errorf("error sending request: %w", err)
-	}
-
-	// Check for successful status code
-	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
-	}
-	return &result, nil
-}
-
 // InviteMyselfToParams represents the parameters for the InviteMyselfTo request
 type InviteMyselfToParams struct {
 	WorldId    string `json:"worldId"`
@@ -4859,6 +5314,10 @@ type InviteMyselfToParams struct {
 }
 
 func (c *Client) InviteMyselfTo(params InviteMyselfToParams) (*SendNotificationResponse, error) {
+	return c.InviteMyselfToWithContext(context.Background(), params)
+}
+
+func (c *Client) InviteMyselfToWithContext(ctx context.Context, params InviteMyselfToParams) (*SendNotificationResponse, error) {
 	path := "/invite/myself/to/{worldId}:{instanceId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -4866,7 +5325,7 @@ func (c *Client) InviteMyselfTo(params InviteMyselfToParams) (*SendNotificationR
 	path = strings.ReplaceAll(path, "{instanceId}", fmt.Sprintf("%v", params.InstanceId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "InviteMyselfTo", "/invite/myself/to/{worldId}:{instanceId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -4874,14 +5333,14 @@ func (c *Client) InviteMyselfTo(params InviteMyselfToParams) (*SendNotificationR
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodPost, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("InviteMyselfTo", resp)
 	}
 	return &result, nil
 }
@@ -4892,13 +5351,17 @@ type RequestInviteParams struct { // UserId A users unique ID, usually in the fo
 }
 
 func (c *Client) RequestInvite(params RequestInviteParams, body RequestInviteRequest) (*NotificationResponse, error) {
+	return c.RequestInviteWithContext(context.Background(), params, body)
+}
+
+func (c *Client) RequestInviteWithContext(ctx context.Context, params RequestInviteParams, body RequestInviteRequest) (*NotificationResponse, error) {
 	path := "/requestInvite/{userId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "RequestInvite", "/requestInvite/{userId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set request body
@@ -4908,14 +5371,14 @@ func (c *Client) RequestInvite(params RequestInviteParams, body RequestInviteReq
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodPost, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("RequestInvite", resp)
 	}
 	return &result, nil
 }
@@ -4925,46 +5388,23 @@ type RequestInviteWithPhotoParams struct { // UserId A users unique ID, usually
 	UserId UserId `json:"userId"`
 }
 
-func (c *Client) RequestInviteWithPhoto(params RequestInviteWithPhotoParams) (*NotificationResponse, error) {
-	path := "/requestInvite/{userId}/photo"
-	// Replace path parameters and prepare query parameters
-	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
-
-	// Create request
-	req := c.client.R()
-	// Set query parameters
-	req.SetQueryParams(queryParams)
-	// Set response object
-	var result NotificationResponse
-	req.SetResult(&result)
-
-	// Send request
-	resp, err := req.Post(path)
-	if err != nil {
-		return nil, fmt.Errorf("error sending request: %w", err)
-	}
-
-	// Check for successful status code
-	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
-	}
-	return &result, nil
-}
-
 // RespondInviteParams represents the parameters for the RespondInvite request
 type RespondInviteParams struct {
 	NotificationId string `json:"notificationId"`
 }
 
 func (c *Client) RespondInvite(params RespondInviteParams, body InviteResponse) (*NotificationResponse, error) {
+	return c.RespondInviteWithContext(context.Background(), params, body)
+}
+
+func (c *Client) RespondInviteWithContext(ctx context.Context, params RespondInviteParams, body InviteResponse) (*NotificationResponse, error) {
 	path := "/invite/{notificationId}/response"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{notificationId}", fmt.Sprintf("%v", params.NotificationId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "RespondInvite", "/invite/{notificationId}/response")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set request body
@@ -4974,14 +5414,14 @@ func (c *Client) RespondInvite(params RespondInviteParams, body InviteResponse)
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodPost, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("RespondInvite", resp)
 	}
 	return &result, nil
 }
@@ -4991,33 +5431,6 @@ type RespondInviteWithPhotoParams struct {
 	NotificationId string `json:"notificationId"`
 }
 
-func (c *Client) RespondInviteWithPhoto(params RespondInviteWithPhotoParams) (*NotificationResponse, error) {
-	path := "/invite/{notificationId}/response/photo"
-	// Replace path parameters and prepare query parameters
-	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{notificationId}", fmt.Sprintf("%v", params.NotificationId))
-
-	// Create request
-	req := c.client.R()
-	// Set query parameters
-	req.SetQueryParams(queryParams)
-	// Set response object
-	var result NotificationResponse
-	req.SetResult(&result)
-
-	// Send request
-	resp, err := req.Post(path)
-	if err != nil {
-		return nil, fmt.Errorf("error sending request: %w", err)
-	}
-
-	// Check for successful status code
-	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
-	}
-	return &result, nil
-}
-
 // GetInviteMessagesParams represents the parameters for the GetInviteMessages request
 type GetInviteMessagesParams struct { // UserId A users unique ID, usually in the form of `usr_c1644b5b-3ca4-45b4-97c6-a2a0de70d469`. Legacy players can have old IDs in the form of `8JoV9XEdpo`. The ID can never be changed.
 	UserId      UserId            `json:"userId"`
@@ -5025,6 +5438,10 @@ type GetInviteMessagesParams struct { // UserId A users unique ID, usually in th
 }
 
 func (c *Client) GetInviteMessages(params GetInviteMessagesParams) (*InviteMessageListResponse, error) {
+	return c.GetInviteMessagesWithContext(context.Background(), params)
+}
+
+func (c *Client) GetInviteMessagesWithContext(ctx context.Context, params GetInviteMessagesParams) (*InviteMessageListResponse, error) {
 	path := "/message/{userId}/{messageType}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -5032,7 +5449,7 @@ func (c *Client) GetInviteMessages(params GetInviteMessagesParams) (*InviteMessa
 	path = strings.ReplaceAll(path, "{messageType}", fmt.Sprintf("%v", params.MessageType))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetInviteMessages", "/message/{userId}/{messageType}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -5040,14 +5457,14 @@ func (c *Client) GetInviteMessages(params GetInviteMessagesParams) (*InviteMessa
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetInviteMessages", resp)
 	}
 	return &result, nil
 }
@@ -5060,6 +5477,10 @@ type ResetInviteMessageParams struct { // UserId A users unique ID, usually in t
 }
 
 func (c *Client) ResetInviteMessage(params ResetInviteMessageParams) (*InviteMessageListResponse, error) {
+	return c.ResetInviteMessageWithContext(context.Background(), params)
+}
+
+func (c *Client) ResetInviteMessageWithContext(ctx context.Context, params ResetInviteMessageParams) (*InviteMessageListResponse, error) {
 	path := "/message/{userId}/{messageType}/{slot}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -5068,7 +5489,7 @@ func (c *Client) ResetInviteMessage(params ResetInviteMessageParams) (*InviteMes
 	path = strings.ReplaceAll(path, "{slot}", fmt.Sprintf("%v", params.Slot))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "ResetInviteMessage", "/message/{userId}/{messageType}/{slot}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -5076,14 +5497,14 @@ func (c *Client) ResetInviteMessage(params ResetInviteMessageParams) (*InviteMes
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Delete(path)
+	resp, err := c.send(req, resty.MethodDelete, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("ResetInviteMessage", resp)
 	}
 	return &result, nil
 }
@@ -5096,6 +5517,10 @@ type GetInviteMessageParams struct { // UserId A users unique ID, usually in the
 }
 
 func (c *Client) GetInviteMessage(params GetInviteMessageParams) (*InviteMessageResponse, error) {
+	return c.GetInviteMessageWithContext(context.Background(), params)
+}
+
+func (c *Client) GetInviteMessageWithContext(ctx context.Context, params GetInviteMessageParams) (*InviteMessageResponse, error) {
 	path := "/message/{userId}/{messageType}/{slot}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -5104,7 +5529,7 @@ func (c *Client) GetInviteMessage(params GetInviteMessageParams) (*InviteMessage
 	path = strings.ReplaceAll(path, "{slot}", fmt.Sprintf("%v", params.Slot))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetInviteMessage", "/message/{userId}/{messageType}/{slot}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -5112,14 +5537,14 @@ func (c *Client) GetInviteMessage(params GetInviteMessageParams) (*InviteMessage
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetInviteMessage", resp)
 	}
 	return &result, nil
 }
@@ -5132,6 +5557,10 @@ type UpdateInviteMessageParams struct { // UserId A users unique ID, usually in
 }
 
 func (c *Client) UpdateInviteMessage(params UpdateInviteMessageParams, body UpdateInviteMessageRequest) (*InviteMessageListResponse, error) {
+	return c.UpdateInviteMessageWithContext(context.Background(), params, body)
+}
+
+func (c *Client) UpdateInviteMessageWithContext(ctx context.Context, params UpdateInviteMessageParams, body UpdateInviteMessageRequest) (*InviteMessageListResponse, error) {
 	path := "/message/{userId}/{messageType}/{slot}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -5140,7 +5569,7 @@ func (c *Client) UpdateInviteMessage(params UpdateInviteMessageParams, body Upda
 	path = strings.ReplaceAll(path, "{slot}", fmt.Sprintf("%v", params.Slot))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "UpdateInviteMessage", "/message/{userId}/{messageType}/{slot}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set request body
@@ -5150,23 +5579,27 @@ func (c *Client) UpdateInviteMessage(params UpdateInviteMessageParams, body Upda
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Put(path)
+	resp, err := c.send(req, resty.MethodPut, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("UpdateInviteMessage", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) CreateInstance(body CreateInstanceRequest) (*InstanceResponse, error) {
+	return c.CreateInstanceWithContext(context.Background(), body)
+}
+
+func (c *Client) CreateInstanceWithContext(ctx context.Context, body CreateInstanceRequest) (*InstanceResponse, error) {
 	path := "/instances"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "CreateInstance", "/instances")
 	// Set request body
 	req.SetBody(body)
 	// Set response object
@@ -5174,37 +5607,41 @@ func (c *Client) CreateInstance(body CreateInstanceRequest) (*InstanceResponse,
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodPost, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("CreateInstance", resp)
 	}
 	return &result, nil
 }
 
 // GetRecentLocationsParams represents the parameters for the GetRecentLocations request
 type GetRecentLocationsParams struct {
-	N      int64 `json:"n"`
-	Offset int64 `json:"offset"`
+	N      Optional[int64] `json:"n"`
+	Offset Optional[int64] `json:"offset"`
 }
 
 func (c *Client) GetRecentLocations(params GetRecentLocationsParams) (*LocationIdListResponse, error) {
+	return c.GetRecentLocationsWithContext(context.Background(), params)
+}
+
+func (c *Client) GetRecentLocationsWithContext(ctx context.Context, params GetRecentLocationsParams) (*LocationIdListResponse, error) {
 	path := "/instances/recent"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if v, ok := params.N.Get(); ok {
+		queryParams["n"] = fmt.Sprintf("%v", v)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if v, ok := params.Offset.Get(); ok {
+		queryParams["offset"] = fmt.Sprintf("%v", v)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetRecentLocations", "/instances/recent")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -5212,14 +5649,14 @@ func (c *Client) GetRecentLocations(params GetRecentLocationsParams) (*LocationI
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetRecentLocations", resp)
 	}
 	return &result, nil
 }
@@ -5231,6 +5668,10 @@ type CloseInstanceParams struct {
 }
 
 func (c *Client) CloseInstance(params CloseInstanceParams) (*InstanceResponse, error) {
+	return c.CloseInstanceWithContext(context.Background(), params)
+}
+
+func (c *Client) CloseInstanceWithContext(ctx context.Context, params CloseInstanceParams) (*InstanceResponse, error) {
 	path := "/instances/{worldId}:{instanceId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -5238,7 +5679,7 @@ func (c *Client) CloseInstance(params CloseInstanceParams) (*InstanceResponse, e
 	path = strings.ReplaceAll(path, "{instanceId}", fmt.Sprintf("%v", params.InstanceId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "CloseInstance", "/instances/{worldId}:{instanceId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -5246,14 +5687,14 @@ func (c *Client) CloseInstance(params CloseInstanceParams) (*InstanceResponse, e
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Delete(path)
+	resp, err := c.send(req, resty.MethodDelete, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("CloseInstance", resp)
 	}
 	return &result, nil
 }
@@ -5265,6 +5706,10 @@ type GetInstanceParams struct {
 }
 
 func (c *Client) GetInstance(params GetInstanceParams) (*InstanceResponse, error) {
+	return c.GetInstanceWithContext(context.Background(), params)
+}
+
+func (c *Client) GetInstanceWithContext(ctx context.Context, params GetInstanceParams) (*InstanceResponse, error) {
 	path := "/instances/{worldId}:{instanceId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -5272,7 +5717,7 @@ func (c *Client) GetInstance(params GetInstanceParams) (*InstanceResponse, error
 	path = strings.ReplaceAll(path, "{instanceId}", fmt.Sprintf("%v", params.InstanceId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetInstance", "/instances/{worldId}:{instanceId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -5280,14 +5725,14 @@ func (c *Client) GetInstance(params GetInstanceParams) (*InstanceResponse, error
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetInstance", resp)
 	}
 	return &result, nil
 }
@@ -5299,6 +5744,10 @@ type GetShortNameParams struct {
 }
 
 func (c *Client) GetShortName(params GetShortNameParams) (*InstanceShortNameResponse, error) {
+	return c.GetShortNameWithContext(context.Background(), params)
+}
+
+func (c *Client) GetShortNameWithContext(ctx context.Context, params GetShortNameParams) (*InstanceShortNameResponse, error) {
 	path := "/instances/{worldId}:{instanceId}/shortName"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -5306,7 +5755,7 @@ func (c *Client) GetShortName(params GetShortNameParams) (*InstanceShortNameResp
 	path = strings.ReplaceAll(path, "{instanceId}", fmt.Sprintf("%v", params.InstanceId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetShortName", "/instances/{worldId}:{instanceId}/shortName")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -5314,59 +5763,74 @@ func (c *Client) GetShortName(params GetShortNameParams) (*InstanceShortNameResp
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetShortName", resp)
 	}
 	return &result, nil
 }
 
-func (c *Client) GetInstanceByShortName() (*InstanceResponse, error) {
+// GetInstanceByShortNameParams represents the parameters for the GetInstanceByShortName request
+type GetInstanceByShortNameParams struct {
+	ShortName string `json:"shortName"`
+}
+
+func (c *Client) GetInstanceByShortName(params GetInstanceByShortNameParams) (*InstanceResponse, error) {
+	return c.GetInstanceByShortNameWithContext(context.Background(), params)
+}
+
+func (c *Client) GetInstanceByShortNameWithContext(ctx context.Context, params GetInstanceByShortNameParams) (*InstanceResponse, error) {
 	path := "/instances/s/{shortName}"
+	// Replace path parameters
+	path = strings.ReplaceAll(path, "{shortName}", url.PathEscape(params.ShortName))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetInstanceByShortName", "/instances/s/{shortName}")
 	// Set response object
 	var result InstanceResponse
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetInstanceByShortName", resp)
 	}
 	return &result, nil
 }
 
 // GetNotificationsParams represents the parameters for the GetNotifications request
 type GetNotificationsParams struct {
-	N      int64 `json:"n"`
-	Offset int64 `json:"offset"`
+	N      Optional[int64] `json:"n"`
+	Offset Optional[int64] `json:"offset"`
 }
 
 func (c *Client) GetNotifications(params GetNotificationsParams) (*NotificationListResponse, error) {
+	return c.GetNotificationsWithContext(context.Background(), params)
+}
+
+func (c *Client) GetNotificationsWithContext(ctx context.Context, params GetNotificationsParams) (*NotificationListResponse, error) {
 	path := "/auth/user/notifications"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if v, ok := params.N.Get(); ok {
+		queryParams["n"] = fmt.Sprintf("%v", v)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if v, ok := params.Offset.Get(); ok {
+		queryParams["offset"] = fmt.Sprintf("%v", v)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetNotifications", "/auth/user/notifications")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -5374,14 +5838,14 @@ func (c *Client) GetNotifications(params GetNotificationsParams) (*NotificationL
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetNotifications", resp)
 	}
 	return &result, nil
 }
@@ -5392,13 +5856,17 @@ type GetNotificationParams struct {
 }
 
 func (c *Client) GetNotification(params GetNotificationParams) (*NotificationResponse, error) {
+	return c.GetNotificationWithContext(context.Background(), params)
+}
+
+func (c *Client) GetNotificationWithContext(ctx context.Context, params GetNotificationParams) (*NotificationResponse, error) {
 	path := "/auth/user/notifications/{notificationId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{notificationId}", fmt.Sprintf("%v", params.NotificationId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetNotification", "/auth/user/notifications/{notificationId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -5406,14 +5874,14 @@ func (c *Client) GetNotification(params GetNotificationParams) (*NotificationRes
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetNotification", resp)
 	}
 	return &result, nil
 }
@@ -5424,13 +5892,17 @@ type AcceptFriendRequestParams struct {
 }
 
 func (c *Client) AcceptFriendRequest(params AcceptFriendRequestParams) (*FriendSuccess, error) {
+	return c.AcceptFriendRequestWithContext(context.Background(), params)
+}
+
+func (c *Client) AcceptFriendRequestWithContext(ctx context.Context, params AcceptFriendRequestParams) (*FriendSuccess, error) {
 	path := "/auth/user/notifications/{notificationId}/accept"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{notificationId}", fmt.Sprintf("%v", params.NotificationId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "AcceptFriendRequest", "/auth/user/notifications/{notificationId}/accept")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -5438,14 +5910,14 @@ func (c *Client) AcceptFriendRequest(params AcceptFriendRequestParams) (*FriendS
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Put(path)
+	resp, err := c.send(req, resty.MethodPut, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("AcceptFriendRequest", resp)
 	}
 	return &result, nil
 }
@@ -5456,13 +5928,17 @@ type MarkNotificationAsReadParams struct {
 }
 
 func (c *Client) MarkNotificationAsRead(params MarkNotificationAsReadParams) (*NotificationResponse, error) {
+	return c.MarkNotificationAsReadWithContext(context.Background(), params)
+}
+
+func (c *Client) MarkNotificationAsReadWithContext(ctx context.Context, params MarkNotificationAsReadParams) (*NotificationResponse, error) {
 	path := "/auth/user/notifications/{notificationId}/see"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{notificationId}", fmt.Sprintf("%v", params.NotificationId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "MarkNotificationAsRead", "/auth/user/notifications/{notificationId}/see")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -5470,14 +5946,14 @@ func (c *Client) MarkNotificationAsRead(params MarkNotificationAsReadParams) (*N
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Put(path)
+	resp, err := c.send(req, resty.MethodPut, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("MarkNotificationAsRead", resp)
 	}
 	return &result, nil
 }
@@ -5488,13 +5964,17 @@ type DeleteNotificationParams struct {
 }
 
 func (c *Client) DeleteNotification(params DeleteNotificationParams) (*NotificationResponse, error) {
+	return c.DeleteNotificationWithContext(context.Background(), params)
+}
+
+func (c *Client) DeleteNotificationWithContext(ctx context.Context, params DeleteNotificationParams) (*NotificationResponse, error) {
 	path := "/auth/user/notifications/{notificationId}/hide"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{notificationId}", fmt.Sprintf("%v", params.NotificationId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "DeleteNotification", "/auth/user/notifications/{notificationId}/hide")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -5502,45 +5982,53 @@ func (c *Client) DeleteNotification(params DeleteNotificationParams) (*Notificat
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Put(path)
+	resp, err := c.send(req, resty.MethodPut, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("DeleteNotification", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) ClearNotifications() (*ClearNotificationsSuccess, error) {
+	return c.ClearNotificationsWithContext(context.Background())
+}
+
+func (c *Client) ClearNotificationsWithContext(ctx context.Context) (*ClearNotificationsSuccess, error) {
 	path := "/auth/user/notifications/clear"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "ClearNotifications", "/auth/user/notifications/clear")
 	// Set response object
 	var result ClearNotificationsSuccess
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Put(path)
+	resp, err := c.send(req, resty.MethodPut, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("ClearNotifications", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) ModerateUser(body ModerateUserRequest) (*PlayerModerationResponse, error) {
+	return c.ModerateUserWithContext(context.Background(), body)
+}
+
+func (c *Client) ModerateUserWithContext(ctx context.Context, body ModerateUserRequest) (*PlayerModerationResponse, error) {
 	path := "/auth/user/playermoderations"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "ModerateUser", "/auth/user/playermoderations")
 	// Set request body
 	req.SetBody(body)
 	// Set response object
@@ -5548,67 +6036,79 @@ func (c *Client) ModerateUser(body ModerateUserRequest) (*PlayerModerationRespon
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodPost, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("ModerateUser", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) ClearAllPlayerModerations() (*PlayerModerationClearAllSuccess, error) {
+	return c.ClearAllPlayerModerationsWithContext(context.Background())
+}
+
+func (c *Client) ClearAllPlayerModerationsWithContext(ctx context.Context) (*PlayerModerationClearAllSuccess, error) {
 	path := "/auth/user/playermoderations"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "ClearAllPlayerModerations", "/auth/user/playermoderations")
 	// Set response object
 	var result PlayerModerationClearAllSuccess
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Delete(path)
+	resp, err := c.send(req, resty.MethodDelete, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("ClearAllPlayerModerations", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) GetPlayerModerations() (*PlayerModerationListResponse, error) {
+	return c.GetPlayerModerationsWithContext(context.Background())
+}
+
+func (c *Client) GetPlayerModerationsWithContext(ctx context.Context) (*PlayerModerationListResponse, error) {
 	path := "/auth/user/playermoderations"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetPlayerModerations", "/auth/user/playermoderations")
 	// Set response object
 	var result PlayerModerationListResponse
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetPlayerModerations", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) UnmoderateUser(body ModerateUserRequest) (*PlayerModerationUnmoderatedSuccess, error) {
+	return c.UnmoderateUserWithContext(context.Background(), body)
+}
+
+func (c *Client) UnmoderateUserWithContext(ctx context.Context, body ModerateUserRequest) (*PlayerModerationUnmoderatedSuccess, error) {
 	path := "/auth/user/unplayermoderate"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "UnmoderateUser", "/auth/user/unplayermoderate")
 	// Set request body
 	req.SetBody(body)
 	// Set response object
@@ -5616,14 +6116,14 @@ func (c *Client) UnmoderateUser(body ModerateUserRequest) (*PlayerModerationUnmo
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Put(path)
+	resp, err := c.send(req, resty.MethodPut, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("UnmoderateUser", resp)
 	}
 	return &result, nil
 }
@@ -5634,13 +6134,17 @@ type GetUserPrintsParams struct { // UserId A users unique ID, usually in the fo
 }
 
 func (c *Client) GetUserPrints(params GetUserPrintsParams) (*PrintListResponse, error) {
+	return c.GetUserPrintsWithContext(context.Background(), params)
+}
+
+func (c *Client) GetUserPrintsWithContext(ctx context.Context, params GetUserPrintsParams) (*PrintListResponse, error) {
 	path := "/prints/user/{userId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetUserPrints", "/prints/user/{userId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -5648,14 +6152,14 @@ func (c *Client) GetUserPrints(params GetUserPrintsParams) (*PrintListResponse,
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetUserPrints", resp)
 	}
 	return &result, nil
 }
@@ -5666,25 +6170,29 @@ type DeletePrintParams struct {
 }
 
 func (c *Client) DeletePrint(params DeletePrintParams) error {
+	return c.DeletePrintWithContext(context.Background(), params)
+}
+
+func (c *Client) DeletePrintWithContext(ctx context.Context, params DeletePrintParams) error {
 	path := "/prints/{printId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{printId}", fmt.Sprintf("%v", params.PrintId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "DeletePrint", "/prints/{printId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 
 	// Send request
-	resp, err := req.Delete(path)
+	resp, err := c.send(req, resty.MethodDelete, path)
 	if err != nil {
 		return fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return newAPIError("DeletePrint", resp)
 	}
 	return nil
 }
@@ -5695,13 +6203,17 @@ type GetPrintParams struct {
 }
 
 func (c *Client) GetPrint(params GetPrintParams) (*PrintResponse, error) {
+	return c.GetPrintWithContext(context.Background(), params)
+}
+
+func (c *Client) GetPrintWithContext(ctx context.Context, params GetPrintParams) (*PrintResponse, error) {
 	path := "/prints/{printId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{printId}", fmt.Sprintf("%v", params.PrintId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetPrint", "/prints/{printId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -5709,14 +6221,14 @@ func (c *Client) GetPrint(params GetPrintParams) (*PrintResponse, error) {
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetPrint", resp)
 	}
 	return &result, nil
 }
@@ -5727,13 +6239,17 @@ type EditPrintParams struct {
 }
 
 func (c *Client) EditPrint(params EditPrintParams) (*PrintResponse, error) {
+	return c.EditPrintWithContext(context.Background(), params)
+}
+
+func (c *Client) EditPrintWithContext(ctx context.Context, params EditPrintParams) (*PrintResponse, error) {
 	path := "/prints/{printId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{printId}", fmt.Sprintf("%v", params.PrintId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "EditPrint", "/prints/{printId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -5741,36 +6257,14 @@ func (c *Client) EditPrint(params EditPrintParams) (*PrintResponse, error) {
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Post(path)
-	if err != nil {
-		return nil, fmt.Errorf("error sending request: %w", err)
-	}
-
-	// Check for successful status code
-	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
-	}
-	return &result, nil
-}
-
-func (c *Client) UploadPrint() (*PrintResponse, error) {
-	path := "/prints"
-
-	// Create request
-	req := c.client.R()
-	// Set response object
-	var result PrintResponse
-	req.SetResult(&result)
-
-	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodPost, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("EditPrint", resp)
 	}
 	return &result, nil
 }
@@ -5781,13 +6275,17 @@ type GetPropParams struct {
 }
 
 func (c *Client) GetProp(params GetPropParams) (*PropResponse, error) {
+	return c.GetPropWithContext(context.Background(), params)
+}
+
+func (c *Client) GetPropWithContext(ctx context.Context, params GetPropParams) (*PropResponse, error) {
 	path := "/props/{propId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{propId}", fmt.Sprintf("%v", params.PropId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetProp", "/props/{propId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -5795,36 +6293,40 @@ func (c *Client) GetProp(params GetPropParams) (*PropResponse, error) {
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetProp", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) GetJams() (*JamListResponse, error) {
+	return c.GetJamsWithContext(context.Background())
+}
+
+func (c *Client) GetJamsWithContext(ctx context.Context) (*JamListResponse, error) {
 	path := "/jams"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetJams", "/jams")
 	// Set response object
 	var result JamListResponse
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetJams", resp)
 	}
 	return &result, nil
 }
@@ -5835,13 +6337,17 @@ type GetJamParams struct {
 }
 
 func (c *Client) GetJam(params GetJamParams) (*JamResponse, error) {
+	return c.GetJamWithContext(context.Background(), params)
+}
+
+func (c *Client) GetJamWithContext(ctx context.Context, params GetJamParams) (*JamResponse, error) {
 	path := "/jams/{jamId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{jamId}", fmt.Sprintf("%v", params.JamId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetJam", "/jams/{jamId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -5849,14 +6355,14 @@ func (c *Client) GetJam(params GetJamParams) (*JamResponse, error) {
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetJam", resp)
 	}
 	return &result, nil
 }
@@ -5867,13 +6373,17 @@ type GetJamSubmissionsParams struct {
 }
 
 func (c *Client) GetJamSubmissions(params GetJamSubmissionsParams) (*SubmissionListResponse, error) {
+	return c.GetJamSubmissionsWithContext(context.Background(), params)
+}
+
+func (c *Client) GetJamSubmissionsWithContext(ctx context.Context, params GetJamSubmissionsParams) (*SubmissionListResponse, error) {
 	path := "/jams/{jamId}/submissions"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{jamId}", fmt.Sprintf("%v", params.JamId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetJamSubmissions", "/jams/{jamId}/submissions")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -5881,37 +6391,41 @@ func (c *Client) GetJamSubmissions(params GetJamSubmissionsParams) (*SubmissionL
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetJamSubmissions", resp)
 	}
 	return &result, nil
 }
 
 // SearchUsersParams represents the parameters for the SearchUsers request
 type SearchUsersParams struct {
-	N      int64 `json:"n"`
-	Offset int64 `json:"offset"`
+	N      Optional[int64] `json:"n"`
+	Offset Optional[int64] `json:"offset"`
 }
 
 func (c *Client) SearchUsers(params SearchUsersParams) (*LimitedUserSearchListResponse, error) {
+	return c.SearchUsersWithContext(context.Background(), params)
+}
+
+func (c *Client) SearchUsersWithContext(ctx context.Context, params SearchUsersParams) (*LimitedUserSearchListResponse, error) {
 	path := "/users"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if v, ok := params.N.Get(); ok {
+		queryParams["n"] = fmt.Sprintf("%v", v)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if v, ok := params.Offset.Get(); ok {
+		queryParams["offset"] = fmt.Sprintf("%v", v)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "SearchUsers", "/users")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -5919,36 +6433,47 @@ func (c *Client) SearchUsers(params SearchUsersParams) (*LimitedUserSearchListRe
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("SearchUsers", resp)
 	}
 	return &result, nil
 }
 
-func (c *Client) GetUserByName() (*UserResponse, error) {
+// GetUserByNameParams represents the parameters for the GetUserByName request
+type GetUserByNameParams struct {
+	Username string `json:"username"`
+}
+
+func (c *Client) GetUserByName(params GetUserByNameParams) (*UserResponse, error) {
+	return c.GetUserByNameWithContext(context.Background(), params)
+}
+
+func (c *Client) GetUserByNameWithContext(ctx context.Context, params GetUserByNameParams) (*UserResponse, error) {
 	path := "/users/{username}/name"
+	// Replace path parameters
+	path = strings.ReplaceAll(path, "{username}", url.PathEscape(params.Username))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetUserByName", "/users/{username}/name")
 	// Set response object
 	var result UserResponse
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetUserByName", resp)
 	}
 	return &result, nil
 }
@@ -5959,13 +6484,17 @@ type GetUserParams struct { // UserId A users unique ID, usually in the form of
 }
 
 func (c *Client) GetUser(params GetUserParams) (*UserResponse, error) {
+	return c.GetUserWithContext(context.Background(), params)
+}
+
+func (c *Client) GetUserWithContext(ctx context.Context, params GetUserParams) (*UserResponse, error) {
 	path := "/users/{userId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetUser", "/users/{userId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -5973,14 +6502,14 @@ func (c *Client) GetUser(params GetUserParams) (*UserResponse, error) {
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetUser", resp)
 	}
 	return &result, nil
 }
@@ -5991,13 +6520,17 @@ type UpdateUserParams struct { // UserId A users unique ID, usually in the form
 }
 
 func (c *Client) UpdateUser(params UpdateUserParams, body UpdateUserRequest) (*CurrentUserResponse, error) {
+	return c.UpdateUserWithContext(context.Background(), params, body)
+}
+
+func (c *Client) UpdateUserWithContext(ctx context.Context, params UpdateUserParams, body UpdateUserRequest) (*CurrentUserResponse, error) {
 	path := "/users/{userId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "UpdateUser", "/users/{userId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set request body
@@ -6007,14 +6540,14 @@ func (c *Client) UpdateUser(params UpdateUserParams, body UpdateUserRequest) (*C
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Put(path)
+	resp, err := c.send(req, resty.MethodPut, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("UpdateUser", resp)
 	}
 	return &result, nil
 }
@@ -6025,13 +6558,17 @@ type GetUserGroupsParams struct { // UserId A users unique ID, usually in the fo
 }
 
 func (c *Client) GetUserGroups(params GetUserGroupsParams) (*LimitedUserGroupListResponse, error) {
+	return c.GetUserGroupsWithContext(context.Background(), params)
+}
+
+func (c *Client) GetUserGroupsWithContext(ctx context.Context, params GetUserGroupsParams) (*LimitedUserGroupListResponse, error) {
 	path := "/users/{userId}/groups"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetUserGroups", "/users/{userId}/groups")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -6039,14 +6576,14 @@ func (c *Client) GetUserGroups(params GetUserGroupsParams) (*LimitedUserGroupLis
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetUserGroups", resp)
 	}
 	return &result, nil
 }
@@ -6057,13 +6594,17 @@ type GetUserGroupRequestsParams struct { // UserId A users unique ID, usually in
 }
 
 func (c *Client) GetUserGroupRequests(params GetUserGroupRequestsParams) (*GroupListResponse, error) {
+	return c.GetUserGroupRequestsWithContext(context.Background(), params)
+}
+
+func (c *Client) GetUserGroupRequestsWithContext(ctx context.Context, params GetUserGroupRequestsParams) (*GroupListResponse, error) {
 	path := "/users/{userId}/groups/requested"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetUserGroupRequests", "/users/{userId}/groups/requested")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -6071,14 +6612,14 @@ func (c *Client) GetUserGroupRequests(params GetUserGroupRequestsParams) (*Group
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetUserGroupRequests", resp)
 	}
 	return &result, nil
 }
@@ -6089,54 +6630,62 @@ type GetUserRepresentedGroupParams struct { // UserId A users unique ID, usually
 }
 
 func (c *Client) GetUserRepresentedGroup(params GetUserRepresentedGroupParams) error {
+	return c.GetUserRepresentedGroupWithContext(context.Background(), params)
+}
+
+func (c *Client) GetUserRepresentedGroupWithContext(ctx context.Context, params GetUserRepresentedGroupParams) error {
 	path := "/users/{userId}/groups/represented"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetUserRepresentedGroup", "/users/{userId}/groups/represented")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return newAPIError("GetUserRepresentedGroup", resp)
 	}
 	return nil
 }
 
 // GetUserFeedbackParams represents the parameters for the GetUserFeedback request
 type GetUserFeedbackParams struct { // UserId A users unique ID, usually in the form of `usr_c1644b5b-3ca4-45b4-97c6-a2a0de70d469`. Legacy players can have old IDs in the form of `8JoV9XEdpo`. The ID can never be changed.
-	UserId    UserId `json:"userId"`
-	ContentId bool   `json:"contentId"`
-	N         int64  `json:"n"`
-	Offset    int64  `json:"offset"`
+	UserId    UserId          `json:"userId"`
+	ContentId Optional[bool]  `json:"contentId"`
+	N         Optional[int64] `json:"n"`
+	Offset    Optional[int64] `json:"offset"`
 }
 
 func (c *Client) GetUserFeedback(params GetUserFeedbackParams) (*FeedbackListResponse, error) {
+	return c.GetUserFeedbackWithContext(context.Background(), params)
+}
+
+func (c *Client) GetUserFeedbackWithContext(ctx context.Context, params GetUserFeedbackParams) (*FeedbackListResponse, error) {
 	path := "/users/{userId}/feedback"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
-	if lo.IsNotEmpty(params.ContentId) {
-		queryParams["contentId"] = fmt.Sprintf("%v", params.ContentId)
+	if v, ok := params.ContentId.Get(); ok {
+		queryParams["contentId"] = fmt.Sprintf("%v", v)
 	}
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if v, ok := params.N.Get(); ok {
+		queryParams["n"] = fmt.Sprintf("%v", v)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if v, ok := params.Offset.Get(); ok {
+		queryParams["offset"] = fmt.Sprintf("%v", v)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetUserFeedback", "/users/{userId}/feedback")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -6144,37 +6693,41 @@ func (c *Client) GetUserFeedback(params GetUserFeedbackParams) (*FeedbackListRes
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetUserFeedback", resp)
 	}
 	return &result, nil
 }
 
 // GetUserNotesParams represents the parameters for the GetUserNotes request
 type GetUserNotesParams struct {
-	N      int64 `json:"n"`
-	Offset int64 `json:"offset"`
+	N      Optional[int64] `json:"n"`
+	Offset Optional[int64] `json:"offset"`
 }
 
 func (c *Client) GetUserNotes(params GetUserNotesParams) (*UserNoteListResponse, error) {
+	return c.GetUserNotesWithContext(context.Background(), params)
+}
+
+func (c *Client) GetUserNotesWithContext(ctx context.Context, params GetUserNotesParams) (*UserNoteListResponse, error) {
 	path := "/userNotes"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if v, ok := params.N.Get(); ok {
+		queryParams["n"] = fmt.Sprintf("%v", v)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if v, ok := params.Offset.Get(); ok {
+		queryParams["offset"] = fmt.Sprintf("%v", v)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetUserNotes", "/userNotes")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -6182,23 +6735,27 @@ func (c *Client) GetUserNotes(params GetUserNotesParams) (*UserNoteListResponse,
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetUserNotes", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) UpdateUserNote(body UpdateUserNoteRequest) (*UserNoteResponse, error) {
+	return c.UpdateUserNoteWithContext(context.Background(), body)
+}
+
+func (c *Client) UpdateUserNoteWithContext(ctx context.Context, body UpdateUserNoteRequest) (*UserNoteResponse, error) {
 	path := "/userNotes"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "UpdateUserNote", "/userNotes")
 	// Set request body
 	req.SetBody(body)
 	// Set response object
@@ -6206,14 +6763,14 @@ func (c *Client) UpdateUserNote(body UpdateUserNoteRequest) (*UserNoteResponse,
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodPost, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("UpdateUserNote", resp)
 	}
 	return &result, nil
 }
@@ -6224,13 +6781,17 @@ type GetUserNoteParams struct {
 }
 
 func (c *Client) GetUserNote(params GetUserNoteParams) (*UserNoteResponse, error) {
+	return c.GetUserNoteWithContext(context.Background(), params)
+}
+
+func (c *Client) GetUserNoteWithContext(ctx context.Context, params GetUserNoteParams) (*UserNoteResponse, error) {
 	path := "/userNotes/{userNoteId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{userNoteId}", fmt.Sprintf("%v", params.UserNoteId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetUserNote", "/userNotes/{userNoteId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -6238,14 +6799,14 @@ func (c *Client) GetUserNote(params GetUserNoteParams) (*UserNoteResponse, error
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetUserNote", resp)
 	}
 	return &result, nil
 }
@@ -6256,13 +6817,17 @@ type AddTagsParams struct { // UserId A users unique ID, usually in the form of
 }
 
 func (c *Client) AddTags(params AddTagsParams, body ChangeUserTagsRequest) (*CurrentUserResponse, error) {
+	return c.AddTagsWithContext(context.Background(), params, body)
+}
+
+func (c *Client) AddTagsWithContext(ctx context.Context, params AddTagsParams, body ChangeUserTagsRequest) (*CurrentUserResponse, error) {
 	path := "/users/{userId}/addTags"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "AddTags", "/users/{userId}/addTags")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set request body
@@ -6272,14 +6837,14 @@ func (c *Client) AddTags(params AddTagsParams, body ChangeUserTagsRequest) (*Cur
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodPost, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("AddTags", resp)
 	}
 	return &result, nil
 }
@@ -6290,13 +6855,17 @@ type RemoveTagsParams struct { // UserId A users unique ID, usually in the form
 }
 
 func (c *Client) RemoveTags(params RemoveTagsParams, body ChangeUserTagsRequest) (*CurrentUserResponse, error) {
+	return c.RemoveTagsWithContext(context.Background(), params, body)
+}
+
+func (c *Client) RemoveTagsWithContext(ctx context.Context, params RemoveTagsParams, body ChangeUserTagsRequest) (*CurrentUserResponse, error) {
 	path := "/users/{userId}/removeTags"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "RemoveTags", "/users/{userId}/removeTags")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set request body
@@ -6306,14 +6875,14 @@ func (c *Client) RemoveTags(params RemoveTagsParams, body ChangeUserTagsRequest)
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodPost, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("RemoveTags", resp)
 	}
 	return &result, nil
 }
@@ -6325,6 +6894,10 @@ type UpdateBadgeParams struct { // UserId A users unique ID, usually in the form
 }
 
 func (c *Client) UpdateBadge(params UpdateBadgeParams, body UpdateUserBadgeRequest) error {
+	return c.UpdateBadgeWithContext(context.Background(), params, body)
+}
+
+func (c *Client) UpdateBadgeWithContext(ctx context.Context, params UpdateBadgeParams, body UpdateUserBadgeRequest) error {
 	path := "/users/{userId}/badges/{badgeId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -6332,21 +6905,21 @@ func (c *Client) UpdateBadge(params UpdateBadgeParams, body UpdateUserBadgeReque
 	path = strings.ReplaceAll(path, "{badgeId}", fmt.Sprintf("%v", params.BadgeId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "UpdateBadge", "/users/{userId}/badges/{badgeId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set request body
 	req.SetBody(body)
 
 	// Send request
-	resp, err := req.Put(path)
+	resp, err := c.send(req, resty.MethodPut, path)
 	if err != nil {
 		return fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return newAPIError("UpdateBadge", resp)
 	}
 	return nil
 }
@@ -6357,13 +6930,17 @@ type GetUserGroupInstancesParams struct { // UserId A users unique ID, usually i
 }
 
 func (c *Client) GetUserGroupInstances(params GetUserGroupInstancesParams) (*UserGroupInstanceListResponse, error) {
+	return c.GetUserGroupInstancesWithContext(context.Background(), params)
+}
+
+func (c *Client) GetUserGroupInstancesWithContext(ctx context.Context, params GetUserGroupInstancesParams) (*UserGroupInstanceListResponse, error) {
 	path := "/users/{userId}/instances/groups"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetUserGroupInstances", "/users/{userId}/instances/groups")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -6371,14 +6948,14 @@ func (c *Client) GetUserGroupInstances(params GetUserGroupInstancesParams) (*Use
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetUserGroupInstances", resp)
 	}
 	return &result, nil
 }
@@ -6390,6 +6967,10 @@ type CheckUserPersistenceExistsParams struct { // UserId A users unique ID, usua
 }
 
 func (c *Client) CheckUserPersistenceExists(params CheckUserPersistenceExistsParams) error {
+	return c.CheckUserPersistenceExistsWithContext(context.Background(), params)
+}
+
+func (c *Client) CheckUserPersistenceExistsWithContext(ctx context.Context, params CheckUserPersistenceExistsParams) error {
 	path := "/users/{userId}/{worldId}/persist/exists"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -6397,19 +6978,19 @@ func (c *Client) CheckUserPersistenceExists(params CheckUserPersistenceExistsPar
 	path = strings.ReplaceAll(path, "{worldId}", fmt.Sprintf("%v", params.WorldId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "CheckUserPersistenceExists", "/users/{userId}/{worldId}/persist/exists")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return newAPIError("CheckUserPersistenceExists", resp)
 	}
 	return nil
 }
@@ -6421,6 +7002,10 @@ type DeleteUserPersistenceParams struct { // UserId A users unique ID, usually i
 }
 
 func (c *Client) DeleteUserPersistence(params DeleteUserPersistenceParams) error {
+	return c.DeleteUserPersistenceWithContext(context.Background(), params)
+}
+
+func (c *Client) DeleteUserPersistenceWithContext(ctx context.Context, params DeleteUserPersistenceParams) error {
 	path := "/users/{userId}/{worldId}/persist"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -6428,28 +7013,32 @@ func (c *Client) DeleteUserPersistence(params DeleteUserPersistenceParams) error
 	path = strings.ReplaceAll(path, "{worldId}", fmt.Sprintf("%v", params.WorldId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "DeleteUserPersistence", "/users/{userId}/{worldId}/persist")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 
 	// Send request
-	resp, err := req.Delete(path)
+	resp, err := c.send(req, resty.MethodDelete, path)
 	if err != nil {
 		return fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return newAPIError("DeleteUserPersistence", resp)
 	}
 	return nil
 }
 
 func (c *Client) CreateWorld(body CreateWorldRequest) (*WorldResponse, error) {
+	return c.CreateWorldWithContext(context.Background(), body)
+}
+
+func (c *Client) CreateWorldWithContext(ctx context.Context, body CreateWorldRequest) (*WorldResponse, error) {
 	path := "/worlds"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "CreateWorld", "/worlds")
 	// Set request body
 	req.SetBody(body)
 	// Set response object
@@ -6457,42 +7046,46 @@ func (c *Client) CreateWorld(body CreateWorldRequest) (*WorldResponse, error) {
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Post(path)
+	resp, err := c.send(req, resty.MethodPost, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("CreateWorld", resp)
 	}
 	return &result, nil
 }
 
 // SearchWorldsParams represents the parameters for the SearchWorlds request
 type SearchWorldsParams struct {
-	Featured        bool          `json:"featured"`
-	Sort            SortOption    `json:"sort"` // UserId A users unique ID, usually in the form of `usr_c1644b5b-3ca4-45b4-97c6-a2a0de70d469`. Legacy players can have old IDs in the form of `8JoV9XEdpo`. The ID can never be changed.
-	UserId          UserId        `json:"userId"`
-	N               int64         `json:"n"`
-	Order           OrderOption   `json:"order"`
-	Offset          int64         `json:"offset"`
-	Search          string        `json:"search"`
-	Tag             string        `json:"tag"`
-	Notag           string        `json:"notag"`
-	ReleaseStatus   ReleaseStatus `json:"releaseStatus"`
-	MaxUnityVersion string        `json:"maxUnityVersion"`
-	MinUnityVersion string        `json:"minUnityVersion"`
-	Platform        string        `json:"platform"`
-	Fuzzy           bool          `json:"fuzzy"`
+	Featured        Optional[bool]  `json:"featured"`
+	Sort            SortOption      `json:"sort"` // UserId A users unique ID, usually in the form of `usr_c1644b5b-3ca4-45b4-97c6-a2a0de70d469`. Legacy players can have old IDs in the form of `8JoV9XEdpo`. The ID can never be changed.
+	UserId          UserId          `json:"userId"`
+	N               Optional[int64] `json:"n"`
+	Order           OrderOption     `json:"order"`
+	Offset          Optional[int64] `json:"offset"`
+	Search          string          `json:"search"`
+	Tag             string          `json:"tag"`
+	Notag           string          `json:"notag"`
+	ReleaseStatus   ReleaseStatus   `json:"releaseStatus"`
+	MaxUnityVersion string          `json:"maxUnityVersion"`
+	MinUnityVersion string          `json:"minUnityVersion"`
+	Platform        string          `json:"platform"`
+	Fuzzy           Optional[bool]  `json:"fuzzy"`
 }
 
 func (c *Client) SearchWorlds(params SearchWorldsParams) (*LimitedWorldListResponse, error) {
+	return c.SearchWorldsWithContext(context.Background(), params)
+}
+
+func (c *Client) SearchWorldsWithContext(ctx context.Context, params SearchWorldsParams) (*LimitedWorldListResponse, error) {
 	path := "/worlds"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	if lo.IsNotEmpty(params.Featured) {
-		queryParams["featured"] = fmt.Sprintf("%v", params.Featured)
+	if v, ok := params.Featured.Get(); ok {
+		queryParams["featured"] = fmt.Sprintf("%v", v)
 	}
 	if lo.IsNotEmpty(params.Sort) {
 		queryParams["sort"] = fmt.Sprintf("%v", params.Sort)
@@ -6500,14 +7093,14 @@ func (c *Client) SearchWorlds(params SearchWorldsParams) (*LimitedWorldListRespo
 	if lo.IsNotEmpty(params.UserId) {
 		queryParams["userId"] = fmt.Sprintf("%v", params.UserId)
 	}
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if v, ok := params.N.Get(); ok {
+		queryParams["n"] = fmt.Sprintf("%v", v)
 	}
 	if lo.IsNotEmpty(params.Order) {
 		queryParams["order"] = fmt.Sprintf("%v", params.Order)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if v, ok := params.Offset.Get(); ok {
+		queryParams["offset"] = fmt.Sprintf("%v", v)
 	}
 	if lo.IsNotEmpty(params.Search) {
 		queryParams["search"] = fmt.Sprintf("%v", params.Search)
@@ -6530,12 +7123,12 @@ func (c *Client) SearchWorlds(params SearchWorldsParams) (*LimitedWorldListRespo
 	if lo.IsNotEmpty(params.Platform) {
 		queryParams["platform"] = fmt.Sprintf("%v", params.Platform)
 	}
-	if lo.IsNotEmpty(params.Fuzzy) {
-		queryParams["fuzzy"] = fmt.Sprintf("%v", params.Fuzzy)
+	if v, ok := params.Fuzzy.Get(); ok {
+		queryParams["fuzzy"] = fmt.Sprintf("%v", v)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "SearchWorlds", "/worlds")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -6543,52 +7136,56 @@ func (c *Client) SearchWorlds(params SearchWorldsParams) (*LimitedWorldListRespo
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("SearchWorlds", resp)
 	}
 	return &result, nil
 }
 
 // GetActiveWorldsParams represents the parameters for the GetActiveWorlds request
 type GetActiveWorldsParams struct {
-	Featured        bool          `json:"featured"`
-	Sort            SortOption    `json:"sort"`
-	N               int64         `json:"n"`
-	Order           OrderOption   `json:"order"`
-	Offset          int64         `json:"offset"`
-	Search          string        `json:"search"`
-	Tag             string        `json:"tag"`
-	Notag           string        `json:"notag"`
-	ReleaseStatus   ReleaseStatus `json:"releaseStatus"`
-	MaxUnityVersion string        `json:"maxUnityVersion"`
-	MinUnityVersion string        `json:"minUnityVersion"`
-	Platform        string        `json:"platform"`
+	Featured        Optional[bool]  `json:"featured"`
+	Sort            SortOption      `json:"sort"`
+	N               Optional[int64] `json:"n"`
+	Order           OrderOption     `json:"order"`
+	Offset          Optional[int64] `json:"offset"`
+	Search          string          `json:"search"`
+	Tag             string          `json:"tag"`
+	Notag           string          `json:"notag"`
+	ReleaseStatus   ReleaseStatus   `json:"releaseStatus"`
+	MaxUnityVersion string          `json:"maxUnityVersion"`
+	MinUnityVersion string          `json:"minUnityVersion"`
+	Platform        string          `json:"platform"`
 }
 
 func (c *Client) GetActiveWorlds(params GetActiveWorldsParams) (*LimitedWorldListResponse, error) {
+	return c.GetActiveWorldsWithContext(context.Background(), params)
+}
+
+func (c *Client) GetActiveWorldsWithContext(ctx context.Context, params GetActiveWorldsParams) (*LimitedWorldListResponse, error) {
 	path := "/worlds/active"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	if lo.IsNotEmpty(params.Featured) {
-		queryParams["featured"] = fmt.Sprintf("%v", params.Featured)
+	if v, ok := params.Featured.Get(); ok {
+		queryParams["featured"] = fmt.Sprintf("%v", v)
 	}
 	if lo.IsNotEmpty(params.Sort) {
 		queryParams["sort"] = fmt.Sprintf("%v", params.Sort)
 	}
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if v, ok := params.N.Get(); ok {
+		queryParams["n"] = fmt.Sprintf("%v", v)
 	}
 	if lo.IsNotEmpty(params.Order) {
 		queryParams["order"] = fmt.Sprintf("%v", params.Order)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if v, ok := params.Offset.Get(); ok {
+		queryParams["offset"] = fmt.Sprintf("%v", v)
 	}
 	if lo.IsNotEmpty(params.Search) {
 		queryParams["search"] = fmt.Sprintf("%v", params.Search)
@@ -6613,7 +7210,7 @@ func (c *Client) GetActiveWorlds(params GetActiveWorldsParams) (*LimitedWorldLis
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetActiveWorlds", "/worlds/active")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -6621,53 +7218,57 @@ func (c *Client) GetActiveWorlds(params GetActiveWorldsParams) (*LimitedWorldLis
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetActiveWorlds", resp)
 	}
 	return &result, nil
 }
 
 // GetFavoritedWorldsParams represents the parameters for the GetFavoritedWorlds request
 type GetFavoritedWorldsParams struct {
-	Featured        bool          `json:"featured"`
-	Sort            SortOption    `json:"sort"`
-	N               int64         `json:"n"`
-	Order           OrderOption   `json:"order"`
-	Offset          int64         `json:"offset"`
-	Search          string        `json:"search"`
-	Tag             string        `json:"tag"`
-	Notag           string        `json:"notag"`
-	ReleaseStatus   ReleaseStatus `json:"releaseStatus"`
-	MaxUnityVersion string        `json:"maxUnityVersion"`
-	MinUnityVersion string        `json:"minUnityVersion"`
-	Platform        string        `json:"platform"` // UserId A users unique ID, usually in the form of `usr_c1644b5b-3ca4-45b4-97c6-a2a0de70d469`. Legacy players can have old IDs in the form of `8JoV9XEdpo`. The ID can never be changed.
-	UserId          UserId        `json:"userId"`
+	Featured        Optional[bool]  `json:"featured"`
+	Sort            SortOption      `json:"sort"`
+	N               Optional[int64] `json:"n"`
+	Order           OrderOption     `json:"order"`
+	Offset          Optional[int64] `json:"offset"`
+	Search          string          `json:"search"`
+	Tag             string          `json:"tag"`
+	Notag           string          `json:"notag"`
+	ReleaseStatus   ReleaseStatus   `json:"releaseStatus"`
+	MaxUnityVersion string          `json:"maxUnityVersion"`
+	MinUnityVersion string          `json:"minUnityVersion"`
+	Platform        string          `json:"platform"` // UserId A users unique ID, usually in the form of `usr_c1644b5b-3ca4-45b4-97c6-a2a0de70d469`. Legacy players can have old IDs in the form of `8JoV9XEdpo`. The ID can never be changed.
+	UserId          UserId          `json:"userId"`
 }
 
 func (c *Client) GetFavoritedWorlds(params GetFavoritedWorldsParams) (*FavoritedWorldListResponse, error) {
+	return c.GetFavoritedWorldsWithContext(context.Background(), params)
+}
+
+func (c *Client) GetFavoritedWorldsWithContext(ctx context.Context, params GetFavoritedWorldsParams) (*FavoritedWorldListResponse, error) {
 	path := "/worlds/favorites"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	if lo.IsNotEmpty(params.Featured) {
-		queryParams["featured"] = fmt.Sprintf("%v", params.Featured)
+	if v, ok := params.Featured.Get(); ok {
+		queryParams["featured"] = fmt.Sprintf("%v", v)
 	}
 	if lo.IsNotEmpty(params.Sort) {
 		queryParams["sort"] = fmt.Sprintf("%v", params.Sort)
 	}
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if v, ok := params.N.Get(); ok {
+		queryParams["n"] = fmt.Sprintf("%v", v)
 	}
 	if lo.IsNotEmpty(params.Order) {
 		queryParams["order"] = fmt.Sprintf("%v", params.Order)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if v, ok := params.Offset.Get(); ok {
+		queryParams["offset"] = fmt.Sprintf("%v", v)
 	}
 	if lo.IsNotEmpty(params.Search) {
 		queryParams["search"] = fmt.Sprintf("%v", params.Search)
@@ -6695,7 +7296,7 @@ func (c *Client) GetFavoritedWorlds(params GetFavoritedWorldsParams) (*Favorited
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetFavoritedWorlds", "/worlds/favorites")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -6703,53 +7304,57 @@ func (c *Client) GetFavoritedWorlds(params GetFavoritedWorldsParams) (*Favorited
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetFavoritedWorlds", resp)
 	}
 	return &result, nil
 }
 
 // GetRecentWorldsParams represents the parameters for the GetRecentWorlds request
 type GetRecentWorldsParams struct {
-	Featured        bool          `json:"featured"`
-	Sort            SortOption    `json:"sort"`
-	N               int64         `json:"n"`
-	Order           OrderOption   `json:"order"`
-	Offset          int64         `json:"offset"`
-	Search          string        `json:"search"`
-	Tag             string        `json:"tag"`
-	Notag           string        `json:"notag"`
-	ReleaseStatus   ReleaseStatus `json:"releaseStatus"`
-	MaxUnityVersion string        `json:"maxUnityVersion"`
-	MinUnityVersion string        `json:"minUnityVersion"`
-	Platform        string        `json:"platform"` // UserId A users unique ID, usually in the form of `usr_c1644b5b-3ca4-45b4-97c6-a2a0de70d469`. Legacy players can have old IDs in the form of `8JoV9XEdpo`. The ID can never be changed.
-	UserId          UserId        `json:"userId"`
+	Featured        Optional[bool]  `json:"featured"`
+	Sort            SortOption      `json:"sort"`
+	N               Optional[int64] `json:"n"`
+	Order           OrderOption     `json:"order"`
+	Offset          Optional[int64] `json:"offset"`
+	Search          string          `json:"search"`
+	Tag             string          `json:"tag"`
+	Notag           string          `json:"notag"`
+	ReleaseStatus   ReleaseStatus   `json:"releaseStatus"`
+	MaxUnityVersion string          `json:"maxUnityVersion"`
+	MinUnityVersion string          `json:"minUnityVersion"`
+	Platform        string          `json:"platform"` // UserId A users unique ID, usually in the form of `usr_c1644b5b-3ca4-45b4-97c6-a2a0de70d469`. Legacy players can have old IDs in the form of `8JoV9XEdpo`. The ID can never be changed.
+	UserId          UserId          `json:"userId"`
 }
 
 func (c *Client) GetRecentWorlds(params GetRecentWorldsParams) (*LimitedWorldListResponse, error) {
+	return c.GetRecentWorldsWithContext(context.Background(), params)
+}
+
+func (c *Client) GetRecentWorldsWithContext(ctx context.Context, params GetRecentWorldsParams) (*LimitedWorldListResponse, error) {
 	path := "/worlds/recent"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	if lo.IsNotEmpty(params.Featured) {
-		queryParams["featured"] = fmt.Sprintf("%v", params.Featured)
+	if v, ok := params.Featured.Get(); ok {
+		queryParams["featured"] = fmt.Sprintf("%v", v)
 	}
 	if lo.IsNotEmpty(params.Sort) {
 		queryParams["sort"] = fmt.Sprintf("%v", params.Sort)
 	}
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if v, ok := params.N.Get(); ok {
+		queryParams["n"] = fmt.Sprintf("%v", v)
 	}
 	if lo.IsNotEmpty(params.Order) {
 		queryParams["order"] = fmt.Sprintf("%v", params.Order)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if v, ok := params.Offset.Get(); ok {
+		queryParams["offset"] = fmt.Sprintf("%v", v)
 	}
 	if lo.IsNotEmpty(params.Search) {
 		queryParams["search"] = fmt.Sprintf("%v", params.Search)
@@ -6777,7 +7382,7 @@ func (c *Client) GetRecentWorlds(params GetRecentWorldsParams) (*LimitedWorldLis
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetRecentWorlds", "/worlds/recent")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -6785,14 +7390,14 @@ func (c *Client) GetRecentWorlds(params GetRecentWorldsParams) (*LimitedWorldLis
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetRecentWorlds", resp)
 	}
 	return &result, nil
 }
@@ -6803,25 +7408,29 @@ type DeleteWorldParams struct {
 }
 
 func (c *Client) DeleteWorld(params DeleteWorldParams) error {
+	return c.DeleteWorldWithContext(context.Background(), params)
+}
+
+func (c *Client) DeleteWorldWithContext(ctx context.Context, params DeleteWorldParams) error {
 	path := "/worlds/{worldId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{worldId}", fmt.Sprintf("%v", params.WorldId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "DeleteWorld", "/worlds/{worldId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 
 	// Send request
-	resp, err := req.Delete(path)
+	resp, err := c.send(req, resty.MethodDelete, path)
 	if err != nil {
 		return fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return newAPIError("DeleteWorld", resp)
 	}
 	return nil
 }
@@ -6832,13 +7441,17 @@ type GetWorldParams struct {
 }
 
 func (c *Client) GetWorld(params GetWorldParams) (*WorldResponse, error) {
+	return c.GetWorldWithContext(context.Background(), params)
+}
+
+func (c *Client) GetWorldWithContext(ctx context.Context, params GetWorldParams) (*WorldResponse, error) {
 	path := "/worlds/{worldId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{worldId}", fmt.Sprintf("%v", params.WorldId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetWorld", "/worlds/{worldId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -6846,14 +7459,14 @@ func (c *Client) GetWorld(params GetWorldParams) (*WorldResponse, error) {
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetWorld", resp)
 	}
 	return &result, nil
 }
@@ -6864,13 +7477,17 @@ type UpdateWorldParams struct {
 }
 
 func (c *Client) UpdateWorld(params UpdateWorldParams, body UpdateWorldRequest) (*WorldResponse, error) {
+	return c.UpdateWorldWithContext(context.Background(), params, body)
+}
+
+func (c *Client) UpdateWorldWithContext(ctx context.Context, params UpdateWorldParams, body UpdateWorldRequest) (*WorldResponse, error) {
 	path := "/worlds/{worldId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{worldId}", fmt.Sprintf("%v", params.WorldId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "UpdateWorld", "/worlds/{worldId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set request body
@@ -6880,14 +7497,14 @@ func (c *Client) UpdateWorld(params UpdateWorldParams, body UpdateWorldRequest)
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Put(path)
+	resp, err := c.send(req, resty.MethodPut, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("UpdateWorld", resp)
 	}
 	return &result, nil
 }
@@ -6898,13 +7515,17 @@ type GetWorldMetadataParams struct {
 }
 
 func (c *Client) GetWorldMetadata(params GetWorldMetadataParams) (*WorldMetadataResponse, error) {
+	return c.GetWorldMetadataWithContext(context.Background(), params)
+}
+
+func (c *Client) GetWorldMetadataWithContext(ctx context.Context, params GetWorldMetadataParams) (*WorldMetadataResponse, error) {
 	path := "/worlds/{worldId}/metadata"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{worldId}", fmt.Sprintf("%v", params.WorldId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetWorldMetadata", "/worlds/{worldId}/metadata")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -6912,14 +7533,14 @@ func (c *Client) GetWorldMetadata(params GetWorldMetadataParams) (*WorldMetadata
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetWorldMetadata", resp)
 	}
 	return &result, nil
 }
@@ -6930,25 +7551,29 @@ type UnpublishWorldParams struct {
 }
 
 func (c *Client) UnpublishWorld(params UnpublishWorldParams) error {
+	return c.UnpublishWorldWithContext(context.Background(), params)
+}
+
+func (c *Client) UnpublishWorldWithContext(ctx context.Context, params UnpublishWorldParams) error {
 	path := "/worlds/{worldId}/publish"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{worldId}", fmt.Sprintf("%v", params.WorldId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "UnpublishWorld", "/worlds/{worldId}/publish")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 
 	// Send request
-	resp, err := req.Delete(path)
+	resp, err := c.send(req, resty.MethodDelete, path)
 	if err != nil {
 		return fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return newAPIError("UnpublishWorld", resp)
 	}
 	return nil
 }
@@ -6959,13 +7584,17 @@ type GetWorldPublishStatusParams struct {
 }
 
 func (c *Client) GetWorldPublishStatus(params GetWorldPublishStatusParams) (*WorldPublishStatusResponse, error) {
+	return c.GetWorldPublishStatusWithContext(context.Background(), params)
+}
+
+func (c *Client) GetWorldPublishStatusWithContext(ctx context.Context, params GetWorldPublishStatusParams) (*WorldPublishStatusResponse, error) {
 	path := "/worlds/{worldId}/publish"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{worldId}", fmt.Sprintf("%v", params.WorldId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetWorldPublishStatus", "/worlds/{worldId}/publish")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -6973,14 +7602,14 @@ func (c *Client) GetWorldPublishStatus(params GetWorldPublishStatusParams) (*Wor
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetWorldPublishStatus", resp)
 	}
 	return &result, nil
 }
@@ -6991,25 +7620,29 @@ type PublishWorldParams struct {
 }
 
 func (c *Client) PublishWorld(params PublishWorldParams) error {
+	return c.PublishWorldWithContext(context.Background(), params)
+}
+
+func (c *Client) PublishWorldWithContext(ctx context.Context, params PublishWorldParams) error {
 	path := "/worlds/{worldId}/publish"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{worldId}", fmt.Sprintf("%v", params.WorldId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "PublishWorld", "/worlds/{worldId}/publish")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 
 	// Send request
-	resp, err := req.Put(path)
+	resp, err := c.send(req, resty.MethodPut, path)
 	if err != nil {
 		return fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return newAPIError("PublishWorld", resp)
 	}
 	return nil
 }
@@ -7021,6 +7654,10 @@ type GetWorldInstanceParams struct {
 }
 
 func (c *Client) GetWorldInstance(params GetWorldInstanceParams) (*InstanceResponse, error) {
+	return c.GetWorldInstanceWithContext(context.Background(), params)
+}
+
+func (c *Client) GetWorldInstanceWithContext(ctx context.Context, params GetWorldInstanceParams) (*InstanceResponse, error) {
 	path := "/worlds/{worldId}/{instanceId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -7028,7 +7665,7 @@ func (c *Client) GetWorldInstance(params GetWorldInstanceParams) (*InstanceRespo
 	path = strings.ReplaceAll(path, "{instanceId}", fmt.Sprintf("%v", params.InstanceId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetWorldInstance", "/worlds/{worldId}/{instanceId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -7036,36 +7673,40 @@ func (c *Client) GetWorldInstance(params GetWorldInstanceParams) (*InstanceRespo
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetWorldInstance", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) GetConfig() (*ApiConfigResponse, error) {
+	return c.GetConfigWithContext(context.Background())
+}
+
+func (c *Client) GetConfigWithContext(ctx context.Context) (*ApiConfigResponse, error) {
 	path := "/config"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetConfig", "/config")
 	// Set response object
 	var result ApiConfigResponse
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetConfig", resp)
 	}
 	return &result, nil
 }
@@ -7077,6 +7718,10 @@ type GetInfoPushParams struct {
 }
 
 func (c *Client) GetInfoPush(params GetInfoPushParams) (*InfoPushListResponse, error) {
+	return c.GetInfoPushWithContext(context.Background(), params)
+}
+
+func (c *Client) GetInfoPushWithContext(ctx context.Context, params GetInfoPushParams) (*InfoPushListResponse, error) {
 	path := "/infoPush"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -7088,7 +7733,7 @@ func (c *Client) GetInfoPush(params GetInfoPushParams) (*InfoPushListResponse, e
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetInfoPush", "/infoPush")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -7096,14 +7741,14 @@ func (c *Client) GetInfoPush(params GetInfoPushParams) (*InfoPushListResponse, e
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetInfoPush", resp)
 	}
 	return &result, nil
 }
@@ -7116,6 +7761,10 @@ type GetCssParams struct {
 }
 
 func (c *Client) GetCss(params GetCssParams) error {
+	return c.GetCssWithContext(context.Background(), params)
+}
+
+func (c *Client) GetCssWithContext(ctx context.Context, params GetCssParams) error {
 	path := "/css/app.css"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -7127,19 +7776,19 @@ func (c *Client) GetCss(params GetCssParams) error {
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetCss", "/css/app.css")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return newAPIError("GetCss", resp)
 	}
 	return nil
 }
@@ -7152,6 +7801,10 @@ type GetJavaScriptParams struct {
 }
 
 func (c *Client) GetJavaScript(params GetJavaScriptParams) error {
+	return c.GetJavaScriptWithContext(context.Background(), params)
+}
+
+func (c *Client) GetJavaScriptWithContext(ctx context.Context, params GetJavaScriptParams) error {
 	path := "/js/app.js"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
@@ -7163,107 +7816,123 @@ func (c *Client) GetJavaScript(params GetJavaScriptParams) error {
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetJavaScript", "/js/app.js")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return newAPIError("GetJavaScript", resp)
 	}
 	return nil
 }
 
 func (c *Client) GetHealth() (*ApiHealthResponse, error) {
+	return c.GetHealthWithContext(context.Background())
+}
+
+func (c *Client) GetHealthWithContext(ctx context.Context) (*ApiHealthResponse, error) {
 	path := "/health"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetHealth", "/health")
 	// Set response object
 	var result ApiHealthResponse
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetHealth", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) GetCurrentOnlineUsers() (*CurrentOnlineUsersResponse, error) {
+	return c.GetCurrentOnlineUsersWithContext(context.Background())
+}
+
+func (c *Client) GetCurrentOnlineUsersWithContext(ctx context.Context) (*CurrentOnlineUsersResponse, error) {
 	path := "/visits"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetCurrentOnlineUsers", "/visits")
 	// Set response object
 	var result CurrentOnlineUsersResponse
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetCurrentOnlineUsers", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) GetSystemTime() (*SystemTimeResponse, error) {
+	return c.GetSystemTimeWithContext(context.Background())
+}
+
+func (c *Client) GetSystemTimeWithContext(ctx context.Context) (*SystemTimeResponse, error) {
 	path := "/time"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetSystemTime", "/time")
 	// Set response object
 	var result SystemTimeResponse
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetSystemTime", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) GetAssignedPermissions() (*PermissionListResponse, error) {
+	return c.GetAssignedPermissionsWithContext(context.Background())
+}
+
+func (c *Client) GetAssignedPermissionsWithContext(ctx context.Context) (*PermissionListResponse, error) {
 	path := "/auth/permissions"
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetAssignedPermissions", "/auth/permissions")
 	// Set response object
 	var result PermissionListResponse
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetAssignedPermissions", resp)
 	}
 	return &result, nil
 }
@@ -7274,13 +7943,17 @@ type GetPermissionParams struct {
 }
 
 func (c *Client) GetPermission(params GetPermissionParams) (*PermissionResponse, error) {
+	return c.GetPermissionWithContext(context.Background(), params)
+}
+
+func (c *Client) GetPermissionWithContext(ctx context.Context, params GetPermissionParams) (*PermissionResponse, error) {
 	path := "/permissions/{permissionId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
 	path = strings.ReplaceAll(path, "{permissionId}", fmt.Sprintf("%v", params.PermissionId))
 
 	// Create request
-	req := c.client.R()
+	req := c.newRequest(ctx, "GetPermission", "/permissions/{permissionId}")
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -7288,22 +7961,22 @@ func (c *Client) GetPermission(params GetPermissionParams) (*PermissionResponse,
 	req.SetResult(&result)
 
 	// Send request
-	resp, err := req.Get(path)
+	resp, err := c.send(req, resty.MethodGet, path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("GetPermission", resp)
 	}
 	return &result, nil
 }
 
 func (c *Client) SetClient(client *resty.Client) {
-    c.client = client
+	c.client = client
 }
 
 func (c *Client) GetClient() *resty.Client {
-    return c.client
+	return c.client
 }
@@ -0,0 +1,188 @@
+package vrchat
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// DefaultBaseURL is the base URL of the production VRChat API.
+const DefaultBaseURL = "https://api.vrchat.cloud/api/1"
+
+// DefaultTimeout is the request timeout of a Client created without WithTimeout or WithHTTPClient.
+// File transfers are not covered, they are only bounded by their context.
+const DefaultTimeout = 30 * time.Second
+
+// Client is a client for the VRChat API.
+//
+// A Client is safe for concurrent use by multiple goroutines, including while it
+// logs in, completes two-factor authentication or logs in again after its session
+// expired: the session cookies live in a cookie jar that synchronizes access itself,
+// logins are serialized, and requests never modify the shared configuration.
+// A cookie jar given with WithCookieJar must be safe for concurrent use as well.
+// SetClient and changes made through GetClient are not synchronized, they must
+// happen before the Client is used.
+type Client struct {
+	client   *resty.Client
+	limiter  *RateLimiter
+	retry    RetryPolicy
+	sessions SessionStore
+
+	// totpErr is why totpSecret is invalid, logins with credentials return it
+	totpSecret  string
+	totpErr     error
+	credentials CredentialsProvider
+	middleware  []Middleware
+
+	// authMu serializes re-logins, authGeneration counts successful logins
+	authMu         sync.Mutex
+	authGeneration atomic.Uint64
+
+	// transfers sends file transfers, see transferClient
+	logger        resty.Logger
+	transfersOnce sync.Once
+	transfers     *resty.Client
+}
+
+// NewClient returns a Client for the API at baseURL, or DefaultBaseURL if it is empty,
+// sending the given User-Agent. VRChat rejects requests without a User-Agent identifying
+// the application.
+//
+// Without options the client times out after DefaultTimeout, is paced by a RateLimiter
+// allowing DefaultRate requests per second and retries with DefaultRetryPolicy.
+func NewClient(baseURL string, UserAgent string, opts ...Option) *Client {
+	o := options{
+		limiter: NewRateLimiter(DefaultRate, DefaultBurst),
+		retry:   DefaultRetryPolicy,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	var totpErr error
+	if o.totpSecret != "" {
+		totpErr = ValidateTOTPSecret(o.totpSecret)
+	}
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	if o.timeout == 0 && o.httpClient == nil {
+		o.timeout = DefaultTimeout
+	}
+
+	client := o.restyClient().
+		SetBaseURL(baseURL).
+		SetHeader("User-Agent", UserAgent).
+		SetHeader("Accept", "application/json")
+
+	return &Client{
+		client:   client,
+		limiter:  o.limiter,
+		retry:    o.retry,
+		sessions: o.sessions,
+
+		totpSecret:  o.totpSecret,
+		totpErr:     totpErr,
+		credentials: o.credentials,
+		middleware:  o.middleware,
+		logger:      o.restyLogger(),
+	}
+}
+
+// transferClient returns the resty client file uploads and downloads are sent with. It is
+// like the resty client of c, sharing its transport and cookie jar, but its http.Client has
+// no timeout: that timeout also covers reading the body and would cut off every transfer
+// taking longer. Transfers are only bounded by their context.
+func (c *Client) transferClient() *resty.Client {
+	c.transfersOnce.Do(func() {
+		httpClient := *c.client.GetClient()
+		httpClient.Timeout = 0
+		transfers := resty.NewWithClient(&httpClient).SetBaseURL(c.client.BaseURL)
+		transfers.Header = c.client.Header.Clone()
+		if c.logger != nil {
+			transfers.SetLogger(c.logger)
+		}
+		redactDebugLogs(transfers)
+		c.transfers = transfers
+	})
+	return c.transfers
+}
+
+// send executes req, waiting for the rate limiter before every attempt and retrying
+// it as allowed by the retry policy of c. With a CredentialsProvider a 401 makes c
+// log in again, after which the request is sent once more. The middleware of c
+// sees every attempt.
+func (c *Client) send(req *resty.Request, method, path string) (*resty.Response, error) {
+	ctx := req.Context()
+	info := requestInfo(req, method, path)
+	generation := c.authGeneration.Load()
+	reauthenticated := false
+	for attempt := 0; ; attempt++ {
+		if c.limiter != nil {
+			if err := c.limiter.Wait(ctx, path); err != nil {
+				return nil, err
+			}
+		}
+
+		// The cookie jar adds the session to the headers of req, which resty shares with
+		// the sent request, drop it so a retry sends the session of a re-login
+		req.Header.Del("Cookie")
+		if err := c.beforeRequest(ctx, info, req); err != nil {
+			return nil, err
+		}
+		resp, err := req.Execute(method, path)
+		if err != nil {
+			c.onError(ctx, info, err)
+			return resp, err
+		}
+		c.afterResponse(ctx, info, resp)
+
+		if resp.StatusCode() == http.StatusUnauthorized && !reauthenticated && c.canReauthenticate(ctx) {
+			if err := c.reauthenticate(ctx, generation); err != nil {
+				c.onError(ctx, info, err)
+				return resp, err
+			}
+			reauthenticated = true
+			attempt--
+			discardBody(resp)
+			continue
+		}
+
+		delay, ok := c.retry.backoff(attempt, method, resp)
+		if !ok {
+			if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
+				c.onError(ctx, info, newAPIError(info.Operation, resp))
+			}
+			return resp, nil
+		}
+		discardBody(resp)
+		if err := sleep(ctx, delay); err != nil {
+			return resp, err
+		}
+	}
+}
+
+// discardBody closes the body of a response that is not returned to the caller, it is
+// only open for requests made with SetDoNotParseResponse.
+func discardBody(resp *resty.Response) {
+	if body := resp.RawBody(); body != nil {
+		body.Close()
+	}
+}
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("waiting to retry: %w", ctx.Err())
+	}
+}
@@ -0,0 +1,98 @@
+package vrchat
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Errors matching the HTTP status of an *APIError with errors.Is.
+//
+// The errors named by the API specification (ErrGroupNotFound, ErrFavoriteAddAlreadyFavorited, ...)
+// live in errors.gen.go and match the operation and status they are documented for.
+var (
+	ErrBadRequest   = errors.New("vrchat: bad request")
+	ErrUnauthorized = errors.New("vrchat: unauthorized")
+	ErrForbidden    = errors.New("vrchat: forbidden")
+	ErrNotFound     = errors.New("vrchat: not found")
+	ErrRateLimited  = errors.New("vrchat: rate limited")
+	ErrServer       = errors.New("vrchat: server error")
+)
+
+// Error messages include at most this many bytes of a body that is not an Error.
+const maxErrorBody = 512
+
+// APIError is returned by every client method when the API answers with a non-2xx status.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Method is the HTTP method of the request.
+	Method string
+	// Endpoint is the path that was requested.
+	Endpoint string
+	// Operation is the name of the client method that sent the request, e.g. "GetGroup".
+	Operation string
+	// Response is the error object decoded from the body, it is empty if the body was not an Error.
+	Response Response
+	// Body is the raw response body.
+	Body string
+	// Err is the error named by the specification for this operation and status, or nil.
+	Err error
+}
+
+// newAPIError builds the *APIError for a failed response of the given operation.
+func newAPIError(operation string, resp *resty.Response) error {
+	e := &APIError{
+		StatusCode: resp.StatusCode(),
+		Operation:  operation,
+		Body:       resp.String(),
+	}
+	if req := resp.Request; req != nil {
+		e.Method = req.Method
+		e.Endpoint = req.URL
+		if req.RawRequest != nil {
+			e.Endpoint = req.RawRequest.URL.Path
+		}
+	}
+
+	var body Error
+	if err := json.Unmarshal(resp.Body(), &body); err == nil {
+		e.Response = body.Error
+	}
+
+	e.Err = operationErrors[operation][e.StatusCode]
+	return e
+}
+
+func (e *APIError) Error() string {
+	msg := redactBody(e.Response.Message)
+	if msg == "" {
+		msg = truncate(redactBody(e.Body), maxErrorBody)
+	}
+	if msg == "" {
+		msg = http.StatusText(e.StatusCode)
+	}
+	return fmt.Sprintf("vrchat: %s %s: unexpected status code %d: %s", e.Method, e.Endpoint, e.StatusCode, msg)
+}
+
+// Is reports whether target is the status sentinel or the named specification error matching e.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return e.StatusCode == http.StatusBadRequest
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrServer:
+		return e.StatusCode >= http.StatusInternalServerError
+	}
+	return e.Err != nil && e.Err == target
+}
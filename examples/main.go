@@ -3,45 +3,44 @@ package main
 import (
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/mchauge/vrchat-api-go"
 )
 
 func main() {
-	client := vrchat.NewClient("https://api.vrchat.cloud/api/1", "My-App-Name/1.0")
+	client := vrchat.NewClient(vrchat.DefaultBaseURL, "My-App-Name/1.0",
+		vrchat.WithSessionStore(vrchat.FileSessionStore{Path: "session.json"}),
+	)
 
-	resp, err := client.Authenticate("Username", "password")
+	login, err := client.Login("Username", "password")
 	if err != nil {
 		panic(err)
 	}
 
-	var authCode string
-	if strings.Contains(resp, "requiresTwoFactorAuth") {
-		log.Println("2FA required, please enter code:")
+	user := login.User
+	if user == nil {
+		method := login.RequiresTwoFactorAuth[0]
+		if login.Requires(vrchat.TwoFactorTOTP) {
+			method = vrchat.TwoFactorTOTP
+		}
+
+		var authCode string
+		log.Printf("2FA required (%s), please enter code:", method)
 		if _, err := fmt.Scan(&authCode); err != nil {
 			panic(fmt.Sprintf("Unable to read 2FA code: %v", err))
 		}
-	}
 
-	if resp == "{\"requiresTwoFactorAuth\":[\"totp\",\"otp\"]}" || resp == "{\"requiresTwoFactorAuth\":[\"emailOtp\"]}" {
-		resp, err = client.VerifyTOTP("username", "password", authCode)
+		user, err = client.CompleteLogin(method, authCode)
 		if err != nil {
 			panic(err)
 		}
-		log.Println(resp)
-	}
-
-	user, err := client.GetCurrentUser()
-	if err != nil {
-		panic(err)
 	}
 
 	println("logged in as ", user.DisplayName, user.Id)
 
 	user_data := vrchat.UpdateUserRequest{
-		Status: vrchat.UserStatusActive,
-		StatusDescription: "I'm a bot",
+		Status:            vrchat.Some(vrchat.UserStatusActive),
+		StatusDescription: vrchat.Some("I'm a bot"),
 	}
 
 	resp1, err := client.UpdateUser(vrchat.UpdateUserParams{UserId: user.Id}, user_data)
@@ -0,0 +1,183 @@
+package vrchat
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// ErrChecksumMismatch is returned when downloaded file data does not match the MD5 or size of its version.
+var ErrChecksumMismatch = errors.New("vrchat: downloaded file does not match its checksum")
+
+// FileDownload configures DownloadFileVersionTo.
+type FileDownload struct {
+	// Offset is the number of bytes of the file the writer holds already, the rest is requested
+	// with a Range header.
+	Offset int64
+	// Existing reads the Offset bytes the writer holds, they are hashed to verify the MD5 of the
+	// whole file. Without it a resumed download is only checked for its size.
+	Existing io.Reader
+}
+
+// DownloadFileVersionTo streams the file data of a file version to w, following the redirect to
+// the CDN, and verifies it against the MD5 and size of the version. It returns the number of bytes
+// written to w.
+//
+// Unlike DownloadFileVersion the content is never buffered in memory, and the download is
+// not cut off by the timeout of the Client, only by the context.
+func (c *Client) DownloadFileVersionTo(params DownloadFileVersionParams, w io.Writer, download FileDownload) (int64, error) {
+	return c.DownloadFileVersionToWithContext(context.Background(), params, w, download)
+}
+
+// DownloadFileVersionToWithContext is like DownloadFileVersionTo but sends the requests with ctx.
+func (c *Client) DownloadFileVersionToWithContext(ctx context.Context, params DownloadFileVersionParams, w io.Writer, download FileDownload) (int64, error) {
+	file, err := c.GetFileWithContext(ctx, GetFileParams{FileId: params.FileId})
+	if err != nil {
+		return 0, err
+	}
+	var data *FileData
+	for _, v := range file.Versions {
+		if v.Version == params.VersionId {
+			data = &v.File
+			break
+		}
+	}
+	if data == nil {
+		return 0, fmt.Errorf("vrchat: file %s has no version %d", params.FileId, params.VersionId)
+	}
+
+	// The bytes before Offset are hashed from Existing, or from the response if the server
+	// sends the whole file anyway
+	sum := md5.New()
+	verify := download.Offset == 0 || download.Existing != nil
+	skipped := io.Discard
+	if download.Offset > 0 && download.Existing != nil {
+		if _, err := io.CopyN(sum, download.Existing, download.Offset); err != nil {
+			return 0, fmt.Errorf("reading downloaded content: %w", err)
+		}
+	} else {
+		skipped = sum
+	}
+
+	var n int64
+	if data.SizeInBytes == 0 || download.Offset < data.SizeInBytes {
+		var restarted bool
+		n, restarted, err = c.downloadFileVersion(ctx, params, w, download.Offset, sum, skipped)
+		if err != nil {
+			return n, err
+		}
+		verify = verify || restarted
+	}
+
+	if data.SizeInBytes > 0 && download.Offset+n != data.SizeInBytes {
+		return n, fmt.Errorf("%w: got %d of %d bytes", ErrChecksumMismatch, download.Offset+n, data.SizeInBytes)
+	}
+	if verify && data.Md5 != "" {
+		if got := base64.StdEncoding.EncodeToString(sum.Sum(nil)); got != data.Md5 {
+			return n, fmt.Errorf("%w: MD5 is %s, want %s", ErrChecksumMismatch, got, data.Md5)
+		}
+	}
+	return n, nil
+}
+
+// downloadFileVersion copies the file data from offset on to w and sum. When the server ignores
+// the Range header the first offset bytes of the response are copied to skipped and restarted is true.
+func (c *Client) downloadFileVersion(ctx context.Context, params DownloadFileVersionParams, w io.Writer, offset int64, sum hash.Hash, skipped io.Writer) (n int64, restarted bool, err error) {
+	path := "/file/{fileId}/{versionId}"
+	// Replace path parameters
+	path = strings.ReplaceAll(path, "{fileId}", fmt.Sprintf("%v", params.FileId))
+	path = strings.ReplaceAll(path, "{versionId}", fmt.Sprintf("%v", params.VersionId))
+
+	// Create request
+	req := c.newTransferRequest(ctx, "DownloadFileVersion", "/file/{fileId}/{versionId}")
+	req.SetHeader("Accept", "*/*")
+	if offset > 0 {
+		req.SetHeader("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+	req.SetDoNotParseResponse(true)
+
+	// Send request
+	resp, err := c.send(req, resty.MethodGet, path)
+	if err != nil {
+		return 0, false, fmt.Errorf("error sending request: %w", err)
+	}
+	body := resp.RawBody()
+	defer body.Close()
+
+	// Check for successful status code
+	switch resp.StatusCode() {
+	case http.StatusPartialContent:
+	case http.StatusOK:
+		if offset > 0 {
+			if _, err := io.CopyN(skipped, body, offset); err != nil {
+				return 0, false, fmt.Errorf("error reading response: %w", err)
+			}
+			restarted = true
+		}
+	default:
+		msg, _ := io.ReadAll(io.LimitReader(body, maxErrorBody))
+		resp.SetBody(msg)
+		return 0, false, newAPIError("DownloadFileVersion", resp)
+	}
+
+	n, err = io.Copy(io.MultiWriter(w, sum), body)
+	if err != nil {
+		return n, restarted, fmt.Errorf("error reading response: %w", err)
+	}
+	return n, restarted, nil
+}
+
+// DownloadFileVersionToFile downloads the file data of a file version to the local file at path.
+// An existing partial download at path is resumed, a complete one is only verified. When
+// the existing content does not match the version, the file is truncated and downloaded again.
+func (c *Client) DownloadFileVersionToFile(params DownloadFileVersionParams, path string) error {
+	return c.DownloadFileVersionToFileWithContext(context.Background(), params, path)
+}
+
+// DownloadFileVersionToFileWithContext is like DownloadFileVersionToFile but sends the requests with ctx.
+func (c *Client) DownloadFileVersionToFileWithContext(ctx context.Context, params DownloadFileVersionParams, path string) error {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	offset := info.Size()
+	err = c.downloadFileVersionToFile(ctx, params, f, offset)
+	if errors.Is(err, ErrChecksumMismatch) && offset > 0 {
+		// The existing content is corrupt or of another version, start over
+		if err := f.Truncate(0); err != nil {
+			return err
+		}
+		err = c.downloadFileVersionToFile(ctx, params, f, 0)
+	}
+	if err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// downloadFileVersionToFile downloads the file data after the first offset bytes of f, which it holds already.
+func (c *Client) downloadFileVersionToFile(ctx context.Context, params DownloadFileVersionParams, f *os.File, offset int64) error {
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+	_, err := c.DownloadFileVersionToWithContext(ctx, params, f, FileDownload{
+		Offset:   offset,
+		Existing: io.NewSectionReader(f, 0, offset),
+	})
+	return err
+}
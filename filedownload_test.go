@@ -0,0 +1,135 @@
+package vrchat
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeDownloads serves version 1 of testFileId.
+type fakeDownloads struct {
+	content []byte
+	// md5 is the MD5 the file API announces, the one of content when empty
+	md5 string
+	// ignoreRange makes the server answer ranged requests with the whole content
+	ignoreRange bool
+
+	mu     sync.Mutex
+	ranges []string
+}
+
+func newFakeDownloads(t *testing.T, d *fakeDownloads) *Client {
+	if d.md5 == "" {
+		sum := md5.Sum(d.content)
+		d.md5 = base64.StdEncoding.EncodeToString(sum[:])
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /file/{fileId}", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(File{
+			Id: testFileId,
+			Versions: []FileVersion{
+				{Version: 0, Status: FileStatusComplete},
+				{Version: 1, Status: FileStatusComplete, File: FileData{Md5: d.md5, SizeInBytes: int64(len(d.content))}},
+			},
+		})
+	})
+	mux.HandleFunc("GET /file/{fileId}/1", func(w http.ResponseWriter, r *http.Request) {
+		d.mu.Lock()
+		d.ranges = append(d.ranges, r.Header.Get("Range"))
+		d.mu.Unlock()
+		if d.ignoreRange {
+			w.Write(d.content)
+			return
+		}
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(d.content))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return NewClient(server.URL, "test", WithRateLimiter(nil), WithRetryPolicy(RetryPolicy{}))
+}
+
+func testContent(size int) []byte {
+	content := make([]byte, size)
+	for i := range content {
+		content[i] = byte(i * 13)
+	}
+	return content
+}
+
+func TestDownloadFileVersionToFile(t *testing.T) {
+	content := testContent(10000)
+	tests := []struct {
+		name        string
+		existing    []byte
+		ignoreRange bool
+		// ranges are the Range headers of the requests the download sends
+		ranges []string
+	}{
+		{"new file", nil, false, []string{""}},
+		{"resumed", content[:4000], false, []string{"bytes=4000-"}},
+		{"resumed without range support", content[:4000], true, []string{"bytes=4000-"}},
+		{"complete", content, false, nil},
+		{"corrupt", append([]byte("corrupt"), content[7:4000]...), false, []string{"bytes=4000-", ""}},
+		{"corrupt without range support", append([]byte("corrupt"), content[7:4000]...), true, []string{"bytes=4000-", ""}},
+		{"longer", append(bytes.Clone(content), 0), false, []string{""}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d := &fakeDownloads{content: content, ignoreRange: test.ignoreRange}
+			c := newFakeDownloads(t, d)
+			path := filepath.Join(t.TempDir(), "avatar.vrca")
+			if test.existing != nil {
+				if err := os.WriteFile(path, test.existing, 0o600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			params := DownloadFileVersionParams{FileId: testFileId, VersionId: 1}
+			if err := c.DownloadFileVersionToFile(params, path); err != nil {
+				t.Fatalf("DownloadFileVersionToFile: %v", err)
+			}
+			got, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, content) {
+				t.Errorf("downloaded %d bytes that do not match the content", len(got))
+			}
+			if len(d.ranges) != len(test.ranges) {
+				t.Fatalf("sent requests with ranges %q, want %q", d.ranges, test.ranges)
+			}
+			for i := range d.ranges {
+				if d.ranges[i] != test.ranges[i] {
+					t.Errorf("sent requests with ranges %q, want %q", d.ranges, test.ranges)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestDownloadFileVersionToMismatch(t *testing.T) {
+	d := &fakeDownloads{content: testContent(3000), md5: base64.StdEncoding.EncodeToString(make([]byte, md5.Size))}
+	c := newFakeDownloads(t, d)
+
+	var buf bytes.Buffer
+	params := DownloadFileVersionParams{FileId: testFileId, VersionId: 1}
+	if _, err := c.DownloadFileVersionTo(params, &buf, FileDownload{}); !errors.Is(err, ErrChecksumMismatch) {
+		t.Errorf("DownloadFileVersionTo of content with another MD5 = %v, want ErrChecksumMismatch", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "avatar.vrca")
+	if err := c.DownloadFileVersionToFile(params, path); !errors.Is(err, ErrChecksumMismatch) {
+		t.Errorf("DownloadFileVersionToFile of content with another MD5 = %v, want ErrChecksumMismatch", err)
+	}
+}
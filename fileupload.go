@@ -0,0 +1,364 @@
+package vrchat
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// DefaultUploadPartSize is the part size of multipart file uploads, the one used by the VRChat SDK.
+const DefaultUploadPartSize = 100 << 20
+
+// The kinds of file data of a file version.
+const (
+	fileTypeFile      = "file"
+	fileTypeSignature = "signature"
+)
+
+// uploadMultipart is the category of file data uploaded in parts, the API chooses it when the
+// version is created. Other file data is "simple" and sent in a single request.
+const uploadMultipart = "multipart"
+
+// fileMimeTypes guesses the MimeType of a new file from its extension.
+var fileMimeTypes = map[string]MimeType{
+	".vrca": MimeTypeApplicationXAvatar,
+	".vrcw": MimeTypeApplicationXWorld,
+	".png":  MimeTypeImagePng,
+	".jpg":  MimeTypeImageJpeg,
+	".jpeg": MimeTypeImageJpeg,
+	".webp": MimeTypeImageWebp,
+	".gif":  MimeTypeImageGif,
+	".gz":   MimeTypeApplicationGzip,
+}
+
+// FileUpload describes a file version uploaded by UploadFileVersion.
+type FileUpload struct {
+	// FileId is the file to add a version to. When empty a new file is created from
+	// Name, MimeType, Extension and Tags.
+	FileId FileId
+	// Name is the name of a new file, the base name of the local file when empty.
+	Name string
+	// MimeType is the MimeType of a new file, guessed from Extension when empty.
+	MimeType MimeType
+	// Extension is the extension of a new file, e.g. ".vrca", the one of the local file when empty.
+	Extension string
+	// Tags are the tags of a new file.
+	Tags []Tag
+	// PartSize is the part size of a multipart upload, DefaultUploadPartSize when zero.
+	PartSize int64
+	// Progress, when set, is called after every uploaded part with the bytes of the file uploaded so far.
+	Progress func(uploaded, total int64)
+}
+
+// FileUploadError is returned by UploadFileVersion when the upload failed after the file was created.
+// Calling UploadFileVersion again with FileId resumes the upload.
+type FileUploadError struct {
+	FileId FileId
+	// Version is the file version being uploaded, or zero if it was not created.
+	Version int64
+	Err     error
+}
+
+func (e *FileUploadError) Error() string {
+	return fmt.Sprintf("vrchat: uploading version %d of %s: %v", e.Version, e.FileId, e.Err)
+}
+
+func (e *FileUploadError) Unwrap() error {
+	return e.Err
+}
+
+// UploadFileVersion uploads the local file at path as a new version of a file, creating the
+// file unless upload.FileId is set, and returns the finished version.
+//
+// The MD5 and the rsync signature of the file are computed and uploaded with it. Depending on
+// the category chosen by the API the file is sent in a single request or in parts. An unfinished
+// version of the file with the same content is resumed, uploading only the missing parts.
+func (c *Client) UploadFileVersion(path string, upload FileUpload) (*FileVersion, error) {
+	return c.UploadFileVersionWithContext(context.Background(), path, upload)
+}
+
+// UploadFileVersionWithContext is like UploadFileVersion but sends the requests with ctx.
+func (c *Client) UploadFileVersionWithContext(ctx context.Context, path string, upload FileUpload) (*FileVersion, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	// Compute the MD5 and the signature in a single pass
+	sum := md5.New()
+	var signature bytes.Buffer
+	if err := writeSignature(&signature, io.TeeReader(f, sum)); err != nil {
+		return nil, fmt.Errorf("computing signature of %s: %w", path, err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	signatureSum := md5.Sum(signature.Bytes())
+	body := CreateFileVersionRequest{
+		FileMd5:              base64.StdEncoding.EncodeToString(sum.Sum(nil)),
+		FileSizeInBytes:      info.Size(),
+		SignatureMd5:         base64.StdEncoding.EncodeToString(signatureSum[:]),
+		SignatureSizeInBytes: int64(signature.Len()),
+	}
+
+	fileId := upload.FileId
+	if fileId == "" {
+		file, err := c.CreateFileWithContext(ctx, newFileRequest(path, upload))
+		if err != nil {
+			return nil, err
+		}
+		fileId = file.Id
+	}
+
+	version, err := c.fileVersion(ctx, fileId, body)
+	if err != nil {
+		return nil, &FileUploadError{FileId: fileId, Err: err}
+	}
+	if version.Status == FileStatusComplete {
+		return version, nil
+	}
+
+	u := &fileUploader{
+		client:   c,
+		fileId:   fileId,
+		version:  version.Version,
+		partSize: upload.PartSize,
+		progress: upload.Progress,
+	}
+	if u.partSize <= 0 {
+		u.partSize = DefaultUploadPartSize
+	}
+	if err := u.upload(ctx, fileTypeFile, version.File, f, info.Size(), body.FileMd5, upload.mimeType(path)); err != nil {
+		return nil, &FileUploadError{FileId: fileId, Version: version.Version, Err: err}
+	}
+	signatureContent := bytes.NewReader(signature.Bytes())
+	if err := u.upload(ctx, fileTypeSignature, version.Signature, signatureContent, signatureContent.Size(), body.SignatureMd5, MimeTypeApplicationXRsyncSignature); err != nil {
+		return nil, &FileUploadError{FileId: fileId, Version: version.Version, Err: err}
+	}
+
+	file, err := c.GetFileWithContext(ctx, GetFileParams{FileId: string(fileId)})
+	if err != nil {
+		return nil, &FileUploadError{FileId: fileId, Version: version.Version, Err: err}
+	}
+	for _, v := range file.Versions {
+		if v.Version == version.Version {
+			return &v, nil
+		}
+	}
+	return nil, &FileUploadError{FileId: fileId, Version: version.Version, Err: errors.New("version missing from file")}
+}
+
+// newFileRequest describes the file created for the local file at path.
+func newFileRequest(path string, upload FileUpload) CreateFileRequest {
+	req := CreateFileRequest{
+		Name:      upload.Name,
+		MimeType:  upload.mimeType(path),
+		Extension: upload.extension(path),
+		Tags:      upload.Tags,
+	}
+	if req.Name == "" {
+		req.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	return req
+}
+
+func (u FileUpload) extension(path string) string {
+	if u.Extension != "" {
+		return u.Extension
+	}
+	return filepath.Ext(path)
+}
+
+func (u FileUpload) mimeType(path string) MimeType {
+	if u.MimeType != "" {
+		return u.MimeType
+	}
+	if mimeType, ok := fileMimeTypes[strings.ToLower(u.extension(path))]; ok {
+		return mimeType
+	}
+	return MimeTypeApplicationOctetStream
+}
+
+// fileVersion returns the version of fileId to upload body to. The latest version is reused
+// when it holds the same content, an unfinished version with other content is deleted first.
+func (c *Client) fileVersion(ctx context.Context, fileId FileId, body CreateFileVersionRequest) (*FileVersion, error) {
+	file, err := c.GetFileWithContext(ctx, GetFileParams{FileId: string(fileId)})
+	if err != nil {
+		return nil, err
+	}
+	if latest := latestVersion(file.Versions); latest != nil && !latest.Deleted {
+		same := latest.File.Md5 == body.FileMd5 && latest.Signature.Md5 == body.SignatureMd5
+		switch {
+		case same && latest.Status != FileStatusNone:
+			return latest, nil
+		case latest.Status == FileStatusWaiting:
+			params := DeleteFileVersionParams{FileId: string(fileId), VersionId: latest.Version}
+			if _, err := c.DeleteFileVersionWithContext(ctx, params); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	file, err = c.CreateFileVersionWithContext(ctx, CreateFileVersionParams{FileId: string(fileId)}, body)
+	if err != nil {
+		return nil, err
+	}
+	if latest := latestVersion(file.Versions); latest != nil {
+		return latest, nil
+	}
+	return nil, errors.New("created version missing from file")
+}
+
+// latestVersion returns the version with the highest number, or nil.
+func latestVersion(versions []FileVersion) *FileVersion {
+	var latest *FileVersion
+	for i := range versions {
+		if latest == nil || versions[i].Version > latest.Version {
+			latest = &versions[i]
+		}
+	}
+	return latest
+}
+
+// fileUploader sends the file data of one file version.
+type fileUploader struct {
+	client   *Client
+	fileId   FileId
+	version  int64
+	partSize int64
+	progress func(uploaded, total int64)
+}
+
+// upload sends size bytes of content as the file data of the given type unless it is complete already.
+func (u *fileUploader) upload(ctx context.Context, fileType string, data FileData, content io.ReaderAt, size int64, contentMd5 string, mimeType MimeType) error {
+	if data.Status == FileStatusComplete {
+		return nil
+	}
+
+	var finish FinishFileDataUploadRequest
+	if data.Category == uploadMultipart {
+		etags, err := u.uploadParts(ctx, fileType, content, size)
+		if err != nil {
+			return err
+		}
+		finish.Etags = etags
+	} else {
+		url, err := u.start(ctx, fileType, 0)
+		if err != nil {
+			return err
+		}
+		header := http.Header{}
+		header.Set("Content-Type", string(mimeType))
+		header.Set("Content-MD5", contentMd5)
+		if _, err := u.client.putSigned(ctx, url, io.NewSectionReader(content, 0, size), size, header); err != nil {
+			return err
+		}
+		u.report(fileType, size, size)
+	}
+
+	// The API expects these to be zero whatever the number of parts
+	finish.MaxParts, finish.NextPartNumber = "0", "0"
+	params := FinishFileDataUploadParams{FileId: string(u.fileId), VersionId: u.version, FileType: fileType}
+	_, err := u.client.FinishFileDataUploadWithContext(ctx, params, finish)
+	return err
+}
+
+// uploadParts sends content in parts, skipping the parts an earlier attempt uploaded, and
+// returns the ETags of all parts.
+func (u *fileUploader) uploadParts(ctx context.Context, fileType string, content io.ReaderAt, size int64) ([]string, error) {
+	var etags []string
+	next := int64(1)
+	params := GetFileDataUploadStatusParams{FileId: string(u.fileId), VersionId: u.version, FileType: fileType}
+	if status, err := u.client.GetFileDataUploadStatusWithContext(ctx, params); err == nil && status.NextPartNumber > 1 && len(status.Etags) >= int(status.NextPartNumber-1) {
+		for _, etag := range status.Etags[:status.NextPartNumber-1] {
+			etags = append(etags, fmt.Sprint(etag))
+		}
+		next = status.NextPartNumber
+	}
+
+	parts := max((size+u.partSize-1)/u.partSize, 1)
+	for part := next; part <= parts; part++ {
+		url, err := u.start(ctx, fileType, part)
+		if err != nil {
+			return nil, err
+		}
+		offset := (part - 1) * u.partSize
+		length := min(u.partSize, size-offset)
+		etag, err := u.client.putSigned(ctx, url, io.NewSectionReader(content, offset, length), length, http.Header{})
+		if err != nil {
+			return nil, fmt.Errorf("uploading part %d of %d: %w", part, parts, err)
+		}
+		etags = append(etags, etag)
+		u.report(fileType, offset+length, size)
+	}
+	return etags, nil
+}
+
+// report calls the progress callback for the file data of the version.
+func (u *fileUploader) report(fileType string, uploaded, total int64) {
+	if u.progress != nil && fileType == fileTypeFile {
+		u.progress(uploaded, total)
+	}
+}
+
+// start returns the signed URL to upload the file data to, or one part of it when part is not zero.
+// The generated StartFileDataUpload cannot send the partNumber parameter.
+func (u *fileUploader) start(ctx context.Context, fileType string, part int64) (string, error) {
+	path := "/file/{fileId}/{versionId}/{fileType}/start"
+	path = strings.ReplaceAll(path, "{fileId}", string(u.fileId))
+	path = strings.ReplaceAll(path, "{versionId}", fmt.Sprint(u.version))
+	path = strings.ReplaceAll(path, "{fileType}", fileType)
+
+	req := u.client.newRequest(ctx, "StartFileDataUpload", "/file/{fileId}/{versionId}/{fileType}/start")
+	if part > 0 {
+		req.SetQueryParam("partNumber", fmt.Sprint(part))
+	}
+	var result FileUploadUrlResponse
+	req.SetResult(&result)
+
+	resp, err := u.client.send(req, resty.MethodPut, path)
+	if err != nil {
+		return "", fmt.Errorf("error sending request: %w", err)
+	}
+	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
+		return "", newAPIError("StartFileDataUpload", resp)
+	}
+	return result.Url, nil
+}
+
+// putSigned uploads length bytes of body to a signed storage URL and returns the ETag of the upload.
+// The request bypasses the API middleware, the storage requires a known Content-Length.
+// It is sent with the transfer client, so only ctx bounds how long it may take.
+func (c *Client) putSigned(ctx context.Context, url string, body io.Reader, length int64, header http.Header) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, body)
+	if err != nil {
+		return "", err
+	}
+	req.ContentLength = length
+	for key, values := range header {
+		req.Header[key] = values
+	}
+
+	resp, err := c.transferClient().GetClient().Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return "", fmt.Errorf("vrchat: PUT %s: unexpected status code %d: %s", req.URL.Host, resp.StatusCode, msg)
+	}
+	return resp.Header.Get("ETag"), nil
+}
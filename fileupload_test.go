@@ -0,0 +1,430 @@
+package vrchat
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+const testFileId = "file_ce35d830-e20a-4df0-a6d4-5aaef4508044"
+
+// fakeFiles is a fake of the file API and of the storage the signed URLs point at.
+type fakeFiles struct {
+	url string
+
+	mu   sync.Mutex
+	file File
+	// multipart makes new versions upload their file in parts
+	multipart bool
+	// storageDelay delays every upload to the storage
+	storageDelay time.Duration
+	// failPart makes the storage reject this part of a multipart upload
+	failPart int
+	// stored holds the uploaded content by version, file type and part
+	stored  map[string][]byte
+	puts    int
+	created int
+	deleted []int64
+}
+
+func newFakeFiles(t *testing.T) *fakeFiles {
+	f := &fakeFiles{stored: make(map[string][]byte)}
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /file", f.createFile)
+	mux.HandleFunc("GET /file/{fileId}", f.getFile)
+	mux.HandleFunc("POST /file/{fileId}", f.createVersion)
+	mux.HandleFunc("DELETE /file/{fileId}/{versionId}", f.deleteVersion)
+	mux.HandleFunc("PUT /file/{fileId}/{versionId}/{fileType}/start", f.start)
+	mux.HandleFunc("GET /file/{fileId}/{versionId}/{fileType}/status", f.status)
+	mux.HandleFunc("PUT /file/{fileId}/{versionId}/{fileType}/finish", f.finish)
+	mux.HandleFunc("PUT /storage/{version}/{fileType}/{part}", f.store)
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	f.url = server.URL
+	return f
+}
+
+func (f *fakeFiles) client(opts ...Option) *Client {
+	return NewClient(f.url, "test", append([]Option{WithRateLimiter(nil), WithRetryPolicy(RetryPolicy{})}, opts...)...)
+}
+
+func (f *fakeFiles) reply(w http.ResponseWriter, value any) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(value)
+}
+
+func (f *fakeFiles) createFile(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var req CreateFileRequest
+	json.NewDecoder(r.Body).Decode(&req)
+	f.file = File{
+		Id:        testFileId,
+		Name:      req.Name,
+		MimeType:  req.MimeType,
+		Extension: req.Extension,
+		Versions:  []FileVersion{{Version: 0, Status: FileStatusComplete}},
+	}
+	f.reply(w, f.file)
+}
+
+func (f *fakeFiles) getFile(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if r.PathValue("fileId") != testFileId || f.file.Id == "" {
+		http.NotFound(w, r)
+		return
+	}
+	f.reply(w, f.file)
+}
+
+func (f *fakeFiles) createVersion(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var req CreateFileVersionRequest
+	json.NewDecoder(r.Body).Decode(&req)
+	category := "simple"
+	if f.multipart {
+		category = uploadMultipart
+	}
+	f.created++
+	f.file.Versions = append(f.file.Versions, FileVersion{
+		Version:   int64(len(f.file.Versions)),
+		Status:    FileStatusWaiting,
+		File:      FileData{Category: category, Md5: req.FileMd5, SizeInBytes: req.FileSizeInBytes, Status: FileStatusWaiting},
+		Signature: FileData{Category: "simple", Md5: req.SignatureMd5, SizeInBytes: req.SignatureSizeInBytes, Status: FileStatusWaiting},
+	})
+	f.reply(w, f.file)
+}
+
+// version returns the version named by the request, f.mu must be held.
+func (f *fakeFiles) version(r *http.Request) *FileVersion {
+	n, _ := strconv.ParseInt(r.PathValue("versionId"), 10, 64)
+	if r.PathValue("fileId") != testFileId || n < 0 || n >= int64(len(f.file.Versions)) {
+		return nil
+	}
+	return &f.file.Versions[n]
+}
+
+func (f *fakeFiles) deleteVersion(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	v := f.version(r)
+	if v == nil {
+		http.NotFound(w, r)
+		return
+	}
+	v.Deleted = true
+	f.deleted = append(f.deleted, v.Version)
+	f.reply(w, f.file)
+}
+
+func (f *fakeFiles) start(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	v := f.version(r)
+	if v == nil {
+		http.NotFound(w, r)
+		return
+	}
+	part := r.URL.Query().Get("partNumber")
+	if part == "" {
+		part = "0"
+	}
+	f.reply(w, FileUploadUrlResponse{Url: fmt.Sprintf("%s/storage/%d/%s/%s", f.url, v.Version, r.PathValue("fileType"), part)})
+}
+
+// parts returns the ETags of the parts of a multipart upload stored so far, f.mu must be held.
+func (f *fakeFiles) parts(version int64, fileType string) []string {
+	var etags []string
+	for part := 1; ; part++ {
+		content, ok := f.stored[fmt.Sprintf("%d/%s/%d", version, fileType, part)]
+		if !ok {
+			return etags
+		}
+		etags = append(etags, etag(content))
+	}
+}
+
+func (f *fakeFiles) status(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	v := f.version(r)
+	if v == nil {
+		http.NotFound(w, r)
+		return
+	}
+	var status FileVersionUploadStatus
+	for _, etag := range f.parts(v.Version, r.PathValue("fileType")) {
+		status.Etags = append(status.Etags, etag)
+	}
+	status.NextPartNumber = int64(len(status.Etags) + 1)
+	f.reply(w, status)
+}
+
+func (f *fakeFiles) finish(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	v := f.version(r)
+	if v == nil {
+		http.NotFound(w, r)
+		return
+	}
+	var req FinishFileDataUploadRequest
+	json.NewDecoder(r.Body).Decode(&req)
+	fileType := r.PathValue("fileType")
+	data := &v.File
+	if fileType == fileTypeSignature {
+		data = &v.Signature
+	}
+
+	var content []byte
+	if data.Category == uploadMultipart {
+		if !slices.Equal(req.Etags, f.parts(v.Version, fileType)) {
+			http.Error(w, `{"error":{"message":"etags do not match the parts","status_code":400}}`, http.StatusBadRequest)
+			return
+		}
+		for part := range req.Etags {
+			content = append(content, f.stored[fmt.Sprintf("%d/%s/%d", v.Version, fileType, part+1)]...)
+		}
+	} else {
+		content = f.stored[fmt.Sprintf("%d/%s/0", v.Version, fileType)]
+	}
+	if sum := md5.Sum(content); base64.StdEncoding.EncodeToString(sum[:]) != data.Md5 {
+		http.Error(w, `{"error":{"message":"md5 mismatch","status_code":400}}`, http.StatusBadRequest)
+		return
+	}
+	data.Status = FileStatusComplete
+	if v.File.Status == FileStatusComplete && v.Signature.Status == FileStatusComplete {
+		v.Status = FileStatusComplete
+	}
+	f.reply(w, f.file)
+}
+
+func (f *fakeFiles) store(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	delay, failPart := f.storageDelay, f.failPart
+	f.mu.Unlock()
+	time.Sleep(delay)
+
+	content, err := io.ReadAll(r.Body)
+	if err != nil || int64(len(content)) != r.ContentLength {
+		http.Error(w, "incomplete body", http.StatusBadRequest)
+		return
+	}
+	part, _ := strconv.Atoi(r.PathValue("part"))
+	if part == 0 {
+		if sum := md5.Sum(content); r.Header.Get("Content-MD5") != base64.StdEncoding.EncodeToString(sum[:]) {
+			http.Error(w, "Content-MD5 mismatch", http.StatusBadRequest)
+			return
+		}
+	}
+	if part != 0 && part == failPart {
+		http.Error(w, "storage unavailable", http.StatusServiceUnavailable)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.puts++
+	f.stored[fmt.Sprintf("%s/%s/%d", r.PathValue("version"), r.PathValue("fileType"), part)] = content
+	w.Header().Set("ETag", etag(content))
+}
+
+func etag(content []byte) string {
+	sum := md5.Sum(content)
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
+
+// writeTestFile writes size bytes derived from seed to a file in a temporary directory.
+func writeTestFile(t *testing.T, name string, size int, seed byte) string {
+	t.Helper()
+	content := make([]byte, size)
+	for i := range content {
+		content[i] = byte(i*7) + seed
+	}
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, content, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestUploadFileVersionSimple(t *testing.T) {
+	files := newFakeFiles(t)
+	// The storage is slower than the timeout of the API requests, which do not cover transfers
+	files.storageDelay = 300 * time.Millisecond
+	c := files.client(WithTimeout(100 * time.Millisecond))
+
+	path := writeTestFile(t, "image.png", 5000, 1)
+	version, err := c.UploadFileVersion(path, FileUpload{})
+	if err != nil {
+		t.Fatalf("UploadFileVersion: %v", err)
+	}
+	if version.Version != 1 || version.Status != FileStatusComplete {
+		t.Errorf("UploadFileVersion = version %d %s, want the complete version 1", version.Version, version.Status)
+	}
+	if files.file.Name != "image" || files.file.MimeType != MimeTypeImagePng || files.file.Extension != ".png" {
+		t.Errorf("created file %+v, want an image/png named image", files.file)
+	}
+	if files.puts != 2 {
+		t.Errorf("uploaded %d times, want the file and its signature", files.puts)
+	}
+
+	// The same content again reuses the complete version
+	files.puts = 0
+	version, err = c.UploadFileVersion(path, FileUpload{FileId: testFileId})
+	if err != nil {
+		t.Fatalf("UploadFileVersion again: %v", err)
+	}
+	if version.Version != 1 || files.created != 1 || files.puts != 0 {
+		t.Errorf("upload of the same content gave version %d after creating %d versions and %d uploads, want version 1 reused",
+			version.Version, files.created, files.puts)
+	}
+}
+
+func TestUploadFileVersionMultipart(t *testing.T) {
+	files := newFakeFiles(t)
+	files.multipart = true
+	c := files.client()
+
+	var progress []int64
+	path := writeTestFile(t, "avatar.vrca", 2500, 2)
+	version, err := c.UploadFileVersion(path, FileUpload{
+		PartSize: 1024,
+		Progress: func(uploaded, total int64) {
+			if total != 2500 {
+				t.Errorf("progress total %d, want 2500", total)
+			}
+			progress = append(progress, uploaded)
+		},
+	})
+	if err != nil {
+		t.Fatalf("UploadFileVersion: %v", err)
+	}
+	if version.Status != FileStatusComplete {
+		t.Errorf("UploadFileVersion = version %d %s, want a complete version", version.Version, version.Status)
+	}
+	if !slices.Equal(progress, []int64{1024, 2048, 2500}) {
+		t.Errorf("progress %v, want every part", progress)
+	}
+	if files.file.MimeType != MimeTypeApplicationXAvatar {
+		t.Errorf("created file of type %s, want an avatar", files.file.MimeType)
+	}
+}
+
+func TestUploadFileVersionResume(t *testing.T) {
+	files := newFakeFiles(t)
+	files.multipart = true
+	files.failPart = 2
+	c := files.client()
+
+	path := writeTestFile(t, "avatar.vrca", 2500, 3)
+	upload := FileUpload{PartSize: 1024}
+	_, err := c.UploadFileVersion(path, upload)
+	var uploadErr *FileUploadError
+	if !errors.As(err, &uploadErr) || uploadErr.FileId != testFileId || uploadErr.Version != 1 {
+		t.Fatalf("UploadFileVersion with a failing part = %v, want a FileUploadError of version 1", err)
+	}
+
+	// Resuming only sends the parts the storage does not have
+	files.failPart = 0
+	files.puts = 0
+	upload.FileId = uploadErr.FileId
+	version, err := c.UploadFileVersion(path, upload)
+	if err != nil {
+		t.Fatalf("resuming UploadFileVersion: %v", err)
+	}
+	if version.Version != 1 || version.Status != FileStatusComplete || files.created != 1 || len(files.deleted) != 0 {
+		t.Errorf("resumed upload gave version %d %s after creating %d versions and deleting %v, want version 1 completed",
+			version.Version, version.Status, files.created, files.deleted)
+	}
+	if files.puts != 3 {
+		t.Errorf("resumed upload sent %d requests to the storage, want parts 2 and 3 and the signature", files.puts)
+	}
+}
+
+func TestUploadFileVersionReplacesLeftover(t *testing.T) {
+	files := newFakeFiles(t)
+	files.multipart = true
+	files.failPart = 1
+	c := files.client()
+
+	upload := FileUpload{PartSize: 1024}
+	_, err := c.UploadFileVersion(writeTestFile(t, "old.vrca", 2500, 4), upload)
+	if err == nil {
+		t.Fatal("UploadFileVersion with a failing part succeeded")
+	}
+
+	// An unfinished version with other content is deleted and replaced
+	files.failPart = 0
+	upload.FileId = testFileId
+	version, err := c.UploadFileVersion(writeTestFile(t, "new.vrca", 2000, 5), upload)
+	if err != nil {
+		t.Fatalf("UploadFileVersion: %v", err)
+	}
+	if version.Version != 2 || !slices.Equal(files.deleted, []int64{1}) {
+		t.Errorf("upload of other content gave version %d after deleting %v, want version 2 replacing version 1",
+			version.Version, files.deleted)
+	}
+}
+
+// TestWriteSignature checks the signature against the format of librsync: a header of
+// the BLAKE2 magic, the block length and the strong sum length, then for every block its
+// rolling checksum and its BLAKE2b-256 sum.
+func TestWriteSignature(t *testing.T) {
+	var signature bytes.Buffer
+	if err := writeSignature(&signature, bytes.NewReader(nil)); err != nil {
+		t.Fatal(err)
+	}
+	header := []byte{0x72, 0x73, 0x01, 0x37, 0x00, 0x00, 0x08, 0x00, 0x00, 0x00, 0x00, 0x20}
+	if !bytes.Equal(signature.Bytes(), header) {
+		t.Errorf("signature of no content = % x, want the header % x", signature.Bytes(), header)
+	}
+
+	// "abc" is a single short block: s1 = 128+129+130, s2 = 128+257+387
+	signature.Reset()
+	if err := writeSignature(&signature, bytes.NewReader([]byte("abc"))); err != nil {
+		t.Fatal(err)
+	}
+	want := hex.EncodeToString(header) + "03040183" +
+		"bddd813c634239723171ef3fee98579b94964e3bb1cb3e427262c8c068d52319"
+	if got := hex.EncodeToString(signature.Bytes()); got != want {
+		t.Errorf("signature of abc = %s, want %s", got, want)
+	}
+
+	// Content longer than a block is cut in blocks of 2048 bytes
+	content := bytes.Repeat([]byte{0xff}, rsyncBlockLength+1)
+	signature.Reset()
+	if err := writeSignature(&signature, bytes.NewReader(content)); err != nil {
+		t.Fatal(err)
+	}
+	if signature.Len() != len(header)+2*(4+rsyncStrongSumSize) {
+		t.Fatalf("signature of %d bytes is %d bytes long, want two blocks", len(content), signature.Len())
+	}
+	last := signature.Bytes()[len(header)+4+rsyncStrongSumSize:]
+	// A single 0xff byte: s1 = s2 = 255+31
+	if weak := binary.BigEndian.Uint32(last); weak != 0x011e011e {
+		t.Errorf("rolling checksum of the last block = %#x, want 0x011e011e", weak)
+	}
+	if strong := blake2b.Sum256(content[rsyncBlockLength:]); !bytes.Equal(last[4:], strong[:]) {
+		t.Errorf("strong sum of the last block = %x, want %x", last[4:], strong)
+	}
+}
@@ -20,10 +20,6 @@ sed -i -E 's/GroupPermissions GroupPermissions/GroupPermissionsAll GroupPermissi
 go fmt client.gen.go
 go fmt schema.gen.go
 
-# Added UserAgent to NewClient function, otherwise it will return 403
-sed -i 's/func NewClient(baseURL string)/func NewClient(baseURL string, UserAgent string)/' ./client.gen.go
-sed -i 's/client: resty.New().SetBaseURL(baseURL),/client: resty.New().SetBaseURL(baseURL).SetHeader("User-Agent", UserAgent),/' ./client.gen.go
-
 # Added SetClient and GetClient function to easily get the underlying http.client, for better cokkies handeling particulaly
 sed -i '$a \
 \
@@ -34,6 +30,12 @@ func (c *Client) GetClient() *resty.Client {\
     return c.client\
 }' ./client.gen.go
 
+# Post-process the generated client methods (context variants, errors, rate limiting, ...) and update request bodies
+# This also replaces the generated Client and NewClient with the ones in client.go (UserAgent is required, otherwise it will return 403)
+# It fails when a generated path still contains an unreplaced {placeholder}, stop here rather than ship a broken client
+cd utils && go run fix_client.go || exit 1
+cd ..
+
 # Import net/http for the cookie related functions below
 # sed -i '/^import (/a \    "net/http"' ./client.gen.go
 
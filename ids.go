@@ -0,0 +1,609 @@
+package vrchat
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// The ids of the API are a prefix naming the kind of object followed by a UUID, such as
+// usr_c1644b5b-3ca4-45b4-97c6-a2a0de70d469. The id types validate them with Validate
+// and reject malformed ids while decoding, an empty id is accepted there as it stands
+// for a missing one.
+
+// ErrInvalidId is wrapped by the errors of the Parse functions, of Validate and of
+// decoding a malformed id.
+var ErrInvalidId = errors.New("vrchat: invalid id")
+
+// Legacy user ids are made of this many letters and digits.
+const legacyUserIdLength = 10
+
+// validator is implemented by the id types.
+type validator interface {
+	~string
+	Validate() error
+}
+
+// parseId returns s as a T if it is a valid id.
+func parseId[T validator](s string) (T, error) {
+	id := T(s)
+	if err := id.Validate(); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// unmarshalId decodes text into id if it is a valid id or empty.
+func unmarshalId[T validator](id *T, text []byte) error {
+	if len(text) == 0 {
+		*id = ""
+		return nil
+	}
+	parsed, err := parseId[T](string(text))
+	if err != nil {
+		return err
+	}
+	*id = parsed
+	return nil
+}
+
+// validateId checks that id is prefix followed by a UUID, kind names the object in the error.
+func validateId[T ~string](id T, prefix, kind string) error {
+	rest, ok := strings.CutPrefix(string(id), prefix)
+	if !ok || !isUUID(rest) {
+		return fmt.Errorf("%w: %q is not a valid %s id", ErrInvalidId, string(id), kind)
+	}
+	return nil
+}
+
+// isUUID reports whether s is a UUID in its canonical form, e.g. c1644b5b-3ca4-45b4-97c6-a2a0de70d469.
+func isUUID(s string) bool {
+	if len(s) != 36 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch i {
+		case 8, 13, 18, 23:
+			if c != '-' {
+				return false
+			}
+		default:
+			if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// isAlphanumeric reports whether s only holds ASCII letters and digits.
+func isAlphanumeric(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseUserId parses a user id, see UserId.Validate.
+func ParseUserId(s string) (UserId, error) {
+	return parseId[UserId](s)
+}
+
+// Validate checks that id is usr_ followed by a UUID, or a legacy id of 10 letters and digits.
+func (id UserId) Validate() error {
+	if len(id) == legacyUserIdLength && isAlphanumeric(string(id)) {
+		return nil
+	}
+	return validateId(id, "usr_", "user")
+}
+
+// UnmarshalText decodes a user id, rejecting malformed ones.
+func (id *UserId) UnmarshalText(text []byte) error {
+	return unmarshalId(id, text)
+}
+
+// ParseWorldId parses a world id, see WorldId.Validate.
+func ParseWorldId(s string) (WorldId, error) {
+	return parseId[WorldId](s)
+}
+
+// Validate checks that id is wrld_ followed by a UUID.
+func (id WorldId) Validate() error {
+	return validateId(id, "wrld_", "world")
+}
+
+// UnmarshalText decodes a world id, rejecting malformed ones. It also accepts the
+// locations user profiles show instead of a world, such as LocationOffline for users
+// who are not friends.
+func (id *WorldId) UnmarshalText(text []byte) error {
+	switch s := string(text); s {
+	case LocationOffline, LocationPrivate, LocationTraveling:
+		*id = WorldId(s)
+		return nil
+	}
+	return unmarshalId(id, text)
+}
+
+// ParseBadgeId parses a badge id, see BadgeId.Validate.
+func ParseBadgeId(s string) (BadgeId, error) {
+	return parseId[BadgeId](s)
+}
+
+// Validate checks that id is bdg_ followed by a UUID.
+func (id BadgeId) Validate() error {
+	return validateId(id, "bdg_", "badge")
+}
+
+// UnmarshalText decodes a badge id, rejecting malformed ones.
+func (id *BadgeId) UnmarshalText(text []byte) error {
+	return unmarshalId(id, text)
+}
+
+// ParseAvatarId parses an avatar id, see AvatarId.Validate.
+func ParseAvatarId(s string) (AvatarId, error) {
+	return parseId[AvatarId](s)
+}
+
+// Validate checks that id is avtr_ followed by a UUID.
+func (id AvatarId) Validate() error {
+	return validateId(id, "avtr_", "avatar")
+}
+
+// UnmarshalText decodes an avatar id, rejecting malformed ones.
+func (id *AvatarId) UnmarshalText(text []byte) error {
+	return unmarshalId(id, text)
+}
+
+// ParseGroupId parses a group id, see GroupId.Validate.
+func ParseGroupId(s string) (GroupId, error) {
+	return parseId[GroupId](s)
+}
+
+// Validate checks that id is grp_ followed by a UUID.
+func (id GroupId) Validate() error {
+	return validateId(id, "grp_", "group")
+}
+
+// UnmarshalText decodes a group id, rejecting malformed ones.
+func (id *GroupId) UnmarshalText(text []byte) error {
+	return unmarshalId(id, text)
+}
+
+// ParseUnityPackageId parses a Unity package id, see UnityPackageId.Validate.
+func ParseUnityPackageId(s string) (UnityPackageId, error) {
+	return parseId[UnityPackageId](s)
+}
+
+// Validate checks that id is unp_ followed by a UUID.
+func (id UnityPackageId) Validate() error {
+	return validateId(id, "unp_", "Unity package")
+}
+
+// UnmarshalText decodes a Unity package id, rejecting malformed ones.
+func (id *UnityPackageId) UnmarshalText(text []byte) error {
+	return unmarshalId(id, text)
+}
+
+// ParseAvatarStyleId parses an avatar style id, see AvatarStyleId.Validate.
+func ParseAvatarStyleId(s string) (AvatarStyleId, error) {
+	return parseId[AvatarStyleId](s)
+}
+
+// Validate checks that id is avst_ followed by a UUID.
+func (id AvatarStyleId) Validate() error {
+	return validateId(id, "avst_", "avatar style")
+}
+
+// UnmarshalText decodes an avatar style id, rejecting malformed ones.
+func (id *AvatarStyleId) UnmarshalText(text []byte) error {
+	return unmarshalId(id, text)
+}
+
+// ParseCalendarId parses a calendar event id, see CalendarId.Validate.
+func ParseCalendarId(s string) (CalendarId, error) {
+	return parseId[CalendarId](s)
+}
+
+// Validate checks that id is cal_ followed by a UUID.
+func (id CalendarId) Validate() error {
+	return validateId(id, "cal_", "calendar event")
+}
+
+// UnmarshalText decodes a calendar event id, rejecting malformed ones.
+func (id *CalendarId) UnmarshalText(text []byte) error {
+	return unmarshalId(id, text)
+}
+
+// ParseFileId parses a file id, see FileId.Validate.
+func ParseFileId(s string) (FileId, error) {
+	return parseId[FileId](s)
+}
+
+// Validate checks that id is file_ followed by a UUID.
+func (id FileId) Validate() error {
+	return validateId(id, "file_", "file")
+}
+
+// UnmarshalText decodes a file id, rejecting malformed ones.
+func (id *FileId) UnmarshalText(text []byte) error {
+	return unmarshalId(id, text)
+}
+
+// ParseGroupRoleId parses a group role id, see GroupRoleId.Validate.
+func ParseGroupRoleId(s string) (GroupRoleId, error) {
+	return parseId[GroupRoleId](s)
+}
+
+// Validate checks that id is grol_ followed by a UUID.
+func (id GroupRoleId) Validate() error {
+	return validateId(id, "grol_", "group role")
+}
+
+// UnmarshalText decodes a group role id, rejecting malformed ones.
+func (id *GroupRoleId) UnmarshalText(text []byte) error {
+	return unmarshalId(id, text)
+}
+
+// ParseTransactionId parses a transaction id, see TransactionId.Validate.
+func ParseTransactionId(s string) (TransactionId, error) {
+	return parseId[TransactionId](s)
+}
+
+// Validate checks that id is txn_ followed by a UUID.
+func (id TransactionId) Validate() error {
+	return validateId(id, "txn_", "transaction")
+}
+
+// UnmarshalText decodes a transaction id, rejecting malformed ones.
+func (id *TransactionId) UnmarshalText(text []byte) error {
+	return unmarshalId(id, text)
+}
+
+// ParseLicenseGroupId parses a license group id, see LicenseGroupId.Validate.
+func ParseLicenseGroupId(s string) (LicenseGroupId, error) {
+	return parseId[LicenseGroupId](s)
+}
+
+// Validate checks that id is lgrp_ followed by a UUID.
+func (id LicenseGroupId) Validate() error {
+	return validateId(id, "lgrp_", "license group")
+}
+
+// UnmarshalText decodes a license group id, rejecting malformed ones.
+func (id *LicenseGroupId) UnmarshalText(text []byte) error {
+	return unmarshalId(id, text)
+}
+
+// ParseProductId parses a product id, see ProductId.Validate.
+func ParseProductId(s string) (ProductId, error) {
+	return parseId[ProductId](s)
+}
+
+// Validate checks that id is prod_ followed by a UUID.
+func (id ProductId) Validate() error {
+	return validateId(id, "prod_", "product")
+}
+
+// UnmarshalText decodes a product id, rejecting malformed ones.
+func (id *ProductId) UnmarshalText(text []byte) error {
+	return unmarshalId(id, text)
+}
+
+// ParseProductListingVariantId parses a product listing variant id, see ProductListingVariantId.Validate.
+func ParseProductListingVariantId(s string) (ProductListingVariantId, error) {
+	return parseId[ProductListingVariantId](s)
+}
+
+// Validate checks that id is listvar_ followed by a UUID.
+func (id ProductListingVariantId) Validate() error {
+	return validateId(id, "listvar_", "product listing variant")
+}
+
+// UnmarshalText decodes a product listing variant id, rejecting malformed ones.
+func (id *ProductListingVariantId) UnmarshalText(text []byte) error {
+	return unmarshalId(id, text)
+}
+
+// ParseStoreId parses a store id, see StoreId.Validate.
+func ParseStoreId(s string) (StoreId, error) {
+	return parseId[StoreId](s)
+}
+
+// Validate checks that id is esto_ followed by a UUID.
+func (id StoreId) Validate() error {
+	return validateId(id, "esto_", "store")
+}
+
+// UnmarshalText decodes a store id, rejecting malformed ones.
+func (id *StoreId) UnmarshalText(text []byte) error {
+	return unmarshalId(id, text)
+}
+
+// ParseStoreShelfId parses a store shelf id, see StoreShelfId.Validate.
+func ParseStoreShelfId(s string) (StoreShelfId, error) {
+	return parseId[StoreShelfId](s)
+}
+
+// Validate checks that id is ess_ followed by a UUID.
+func (id StoreShelfId) Validate() error {
+	return validateId(id, "ess_", "store shelf")
+}
+
+// UnmarshalText decodes a store shelf id, rejecting malformed ones.
+func (id *StoreShelfId) UnmarshalText(text []byte) error {
+	return unmarshalId(id, text)
+}
+
+// ParseFavoriteId parses a favorite id, see FavoriteId.Validate.
+func ParseFavoriteId(s string) (FavoriteId, error) {
+	return parseId[FavoriteId](s)
+}
+
+// Validate checks that id is fvrt_ followed by a UUID.
+func (id FavoriteId) Validate() error {
+	return validateId(id, "fvrt_", "favorite")
+}
+
+// UnmarshalText decodes a favorite id, rejecting malformed ones.
+func (id *FavoriteId) UnmarshalText(text []byte) error {
+	return unmarshalId(id, text)
+}
+
+// ParseFavoriteGroupId parses a favorite group id, see FavoriteGroupId.Validate.
+func ParseFavoriteGroupId(s string) (FavoriteGroupId, error) {
+	return parseId[FavoriteGroupId](s)
+}
+
+// Validate checks that id is fvgrp_ followed by a UUID.
+func (id FavoriteGroupId) Validate() error {
+	return validateId(id, "fvgrp_", "favorite group")
+}
+
+// UnmarshalText decodes a favorite group id, rejecting malformed ones.
+func (id *FavoriteGroupId) UnmarshalText(text []byte) error {
+	return unmarshalId(id, text)
+}
+
+// ParseGroupGalleryId parses a group gallery id, see GroupGalleryId.Validate.
+func ParseGroupGalleryId(s string) (GroupGalleryId, error) {
+	return parseId[GroupGalleryId](s)
+}
+
+// Validate checks that id is ggal_ followed by a UUID.
+func (id GroupGalleryId) Validate() error {
+	return validateId(id, "ggal_", "group gallery")
+}
+
+// UnmarshalText decodes a group gallery id, rejecting malformed ones.
+func (id *GroupGalleryId) UnmarshalText(text []byte) error {
+	return unmarshalId(id, text)
+}
+
+// ParseGroupMemberId parses a group member id, see GroupMemberId.Validate.
+func ParseGroupMemberId(s string) (GroupMemberId, error) {
+	return parseId[GroupMemberId](s)
+}
+
+// Validate checks that id is gmem_ followed by a UUID.
+func (id GroupMemberId) Validate() error {
+	return validateId(id, "gmem_", "group member")
+}
+
+// UnmarshalText decodes a group member id, rejecting malformed ones.
+func (id *GroupMemberId) UnmarshalText(text []byte) error {
+	return unmarshalId(id, text)
+}
+
+// ParseGroupAnnouncementId parses a group announcement id, see GroupAnnouncementId.Validate.
+func ParseGroupAnnouncementId(s string) (GroupAnnouncementId, error) {
+	return parseId[GroupAnnouncementId](s)
+}
+
+// Validate checks that id is gpos_ followed by a UUID.
+func (id GroupAnnouncementId) Validate() error {
+	return validateId(id, "gpos_", "group announcement")
+}
+
+// UnmarshalText decodes a group announcement id, rejecting malformed ones.
+func (id *GroupAnnouncementId) UnmarshalText(text []byte) error {
+	return unmarshalId(id, text)
+}
+
+// ParseGroupAuditLogId parses a group audit log id, see GroupAuditLogId.Validate.
+func ParseGroupAuditLogId(s string) (GroupAuditLogId, error) {
+	return parseId[GroupAuditLogId](s)
+}
+
+// Validate checks that id is gaud_ followed by a UUID.
+func (id GroupAuditLogId) Validate() error {
+	return validateId(id, "gaud_", "group audit log")
+}
+
+// UnmarshalText decodes a group audit log id, rejecting malformed ones.
+func (id *GroupAuditLogId) UnmarshalText(text []byte) error {
+	return unmarshalId(id, text)
+}
+
+// ParseGroupGalleryImageId parses a group gallery image id, see GroupGalleryImageId.Validate.
+func ParseGroupGalleryImageId(s string) (GroupGalleryImageId, error) {
+	return parseId[GroupGalleryImageId](s)
+}
+
+// Validate checks that id is ggim_ followed by a UUID.
+func (id GroupGalleryImageId) Validate() error {
+	return validateId(id, "ggim_", "group gallery image")
+}
+
+// UnmarshalText decodes a group gallery image id, rejecting malformed ones.
+func (id *GroupGalleryImageId) UnmarshalText(text []byte) error {
+	return unmarshalId(id, text)
+}
+
+// ParseNotificationId parses a notification id, see NotificationId.Validate.
+func ParseNotificationId(s string) (NotificationId, error) {
+	return parseId[NotificationId](s)
+}
+
+// Validate checks that id is not_ followed by a UUID.
+func (id NotificationId) Validate() error {
+	return validateId(id, "not_", "notification")
+}
+
+// UnmarshalText decodes a notification id, rejecting malformed ones.
+func (id *NotificationId) UnmarshalText(text []byte) error {
+	return unmarshalId(id, text)
+}
+
+// ParseInventoryItemId parses an inventory item id, see InventoryItemId.Validate.
+func ParseInventoryItemId(s string) (InventoryItemId, error) {
+	return parseId[InventoryItemId](s)
+}
+
+// Validate checks that id is inv_ followed by a UUID.
+func (id InventoryItemId) Validate() error {
+	return validateId(id, "inv_", "inventory item")
+}
+
+// UnmarshalText decodes an inventory item id, rejecting malformed ones.
+func (id *InventoryItemId) UnmarshalText(text []byte) error {
+	return unmarshalId(id, text)
+}
+
+// ParseInventoryTemplateId parses an inventory template id, see InventoryTemplateId.Validate.
+func ParseInventoryTemplateId(s string) (InventoryTemplateId, error) {
+	return parseId[InventoryTemplateId](s)
+}
+
+// Validate checks that id is invt_ followed by a UUID.
+func (id InventoryTemplateId) Validate() error {
+	return validateId(id, "invt_", "inventory template")
+}
+
+// UnmarshalText decodes an inventory template id, rejecting malformed ones.
+func (id *InventoryTemplateId) UnmarshalText(text []byte) error {
+	return unmarshalId(id, text)
+}
+
+// ParsePropId parses a prop id, see PropId.Validate.
+func ParsePropId(s string) (PropId, error) {
+	return parseId[PropId](s)
+}
+
+// Validate checks that id is prop_ followed by a UUID.
+func (id PropId) Validate() error {
+	return validateId(id, "prop_", "prop")
+}
+
+// UnmarshalText decodes a prop id, rejecting malformed ones.
+func (id *PropId) UnmarshalText(text []byte) error {
+	return unmarshalId(id, text)
+}
+
+// ParseInventoryDropId parses an inventory drop id, see InventoryDropId.Validate.
+func ParseInventoryDropId(s string) (InventoryDropId, error) {
+	return parseId[InventoryDropId](s)
+}
+
+// Validate checks that id is invd_ followed by a UUID.
+func (id InventoryDropId) Validate() error {
+	return validateId(id, "invd_", "inventory drop")
+}
+
+// UnmarshalText decodes an inventory drop id, rejecting malformed ones.
+func (id *InventoryDropId) UnmarshalText(text []byte) error {
+	return unmarshalId(id, text)
+}
+
+// ParseInviteMessageId parses an invite message id, see InviteMessageId.Validate.
+func ParseInviteMessageId(s string) (InviteMessageId, error) {
+	return parseId[InviteMessageId](s)
+}
+
+// Validate checks that id is invm_ followed by a UUID.
+func (id InviteMessageId) Validate() error {
+	return validateId(id, "invm_", "invite message")
+}
+
+// UnmarshalText decodes an invite message id, rejecting malformed ones.
+func (id *InviteMessageId) UnmarshalText(text []byte) error {
+	return unmarshalId(id, text)
+}
+
+// ParsePlayerModerationId parses a player moderation id, see PlayerModerationId.Validate.
+func ParsePlayerModerationId(s string) (PlayerModerationId, error) {
+	return parseId[PlayerModerationId](s)
+}
+
+// Validate checks that id is pmod_ followed by a UUID.
+func (id PlayerModerationId) Validate() error {
+	return validateId(id, "pmod_", "player moderation")
+}
+
+// UnmarshalText decodes a player moderation id, rejecting malformed ones.
+func (id *PlayerModerationId) UnmarshalText(text []byte) error {
+	return unmarshalId(id, text)
+}
+
+// ParsePrintId parses a print id, see PrintId.Validate.
+func ParsePrintId(s string) (PrintId, error) {
+	return parseId[PrintId](s)
+}
+
+// Validate checks that id is prnt_ followed by a UUID.
+func (id PrintId) Validate() error {
+	return validateId(id, "prnt_", "print")
+}
+
+// UnmarshalText decodes a print id, rejecting malformed ones.
+func (id *PrintId) UnmarshalText(text []byte) error {
+	return unmarshalId(id, text)
+}
+
+// ParseFeedbackId parses a feedback id, see FeedbackId.Validate.
+func ParseFeedbackId(s string) (FeedbackId, error) {
+	return parseId[FeedbackId](s)
+}
+
+// Validate checks that id is feedback_ followed by a UUID.
+func (id FeedbackId) Validate() error {
+	return validateId(id, "feedback_", "feedback")
+}
+
+// UnmarshalText decodes a feedback id, rejecting malformed ones.
+func (id *FeedbackId) UnmarshalText(text []byte) error {
+	return unmarshalId(id, text)
+}
+
+// ParseUserNoteId parses an user note id, see UserNoteId.Validate.
+func ParseUserNoteId(s string) (UserNoteId, error) {
+	return parseId[UserNoteId](s)
+}
+
+// Validate checks that id is unt_ followed by a UUID.
+func (id UserNoteId) Validate() error {
+	return validateId(id, "unt_", "user note")
+}
+
+// UnmarshalText decodes an user note id, rejecting malformed ones.
+func (id *UserNoteId) UnmarshalText(text []byte) error {
+	return unmarshalId(id, text)
+}
+
+// ParsePermissionId parses a permission id, see PermissionId.Validate.
+func ParsePermissionId(s string) (PermissionId, error) {
+	return parseId[PermissionId](s)
+}
+
+// Validate checks that id is prms_ followed by a UUID.
+func (id PermissionId) Validate() error {
+	return validateId(id, "prms_", "permission")
+}
+
+// UnmarshalText decodes a permission id, rejecting malformed ones.
+func (id *PermissionId) UnmarshalText(text []byte) error {
+	return unmarshalId(id, text)
+}
@@ -0,0 +1,163 @@
+package vrchat
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+const testUUID = "c1644b5b-3ca4-45b4-97c6-a2a0de70d469"
+
+// idTest checks the parsing and decoding of an id type.
+type idTest struct {
+	prefix string
+	parse  func(s string) error
+	decode func(data []byte) error
+}
+
+func newIdTest[T validator](prefix string, parse func(string) (T, error)) idTest {
+	return idTest{
+		prefix: prefix,
+		parse: func(s string) error {
+			_, err := parse(s)
+			return err
+		},
+		decode: func(data []byte) error {
+			var id T
+			if err := json.Unmarshal(data, &id); err != nil {
+				return err
+			}
+			var s string
+			json.Unmarshal(data, &s)
+			if string(id) != s {
+				return errors.New("decoded " + string(id) + ", want " + s)
+			}
+			return nil
+		},
+	}
+}
+
+func TestParseIds(t *testing.T) {
+	tests := []idTest{
+		newIdTest("usr_", ParseUserId),
+		newIdTest("wrld_", ParseWorldId),
+		newIdTest("bdg_", ParseBadgeId),
+		newIdTest("avtr_", ParseAvatarId),
+		newIdTest("grp_", ParseGroupId),
+		newIdTest("unp_", ParseUnityPackageId),
+		newIdTest("avst_", ParseAvatarStyleId),
+		newIdTest("cal_", ParseCalendarId),
+		newIdTest("file_", ParseFileId),
+		newIdTest("grol_", ParseGroupRoleId),
+		newIdTest("txn_", ParseTransactionId),
+		newIdTest("lgrp_", ParseLicenseGroupId),
+		newIdTest("prod_", ParseProductId),
+		newIdTest("listvar_", ParseProductListingVariantId),
+		newIdTest("esto_", ParseStoreId),
+		newIdTest("ess_", ParseStoreShelfId),
+		newIdTest("fvrt_", ParseFavoriteId),
+		newIdTest("fvgrp_", ParseFavoriteGroupId),
+		newIdTest("ggal_", ParseGroupGalleryId),
+		newIdTest("gmem_", ParseGroupMemberId),
+		newIdTest("gpos_", ParseGroupAnnouncementId),
+		newIdTest("gaud_", ParseGroupAuditLogId),
+		newIdTest("ggim_", ParseGroupGalleryImageId),
+		newIdTest("not_", ParseNotificationId),
+		newIdTest("inv_", ParseInventoryItemId),
+		newIdTest("invt_", ParseInventoryTemplateId),
+		newIdTest("prop_", ParsePropId),
+		newIdTest("invd_", ParseInventoryDropId),
+		newIdTest("invm_", ParseInviteMessageId),
+		newIdTest("pmod_", ParsePlayerModerationId),
+		newIdTest("prnt_", ParsePrintId),
+		newIdTest("feedback_", ParseFeedbackId),
+		newIdTest("unt_", ParseUserNoteId),
+		newIdTest("prms_", ParsePermissionId),
+	}
+	for _, test := range tests {
+		t.Run(test.prefix, func(t *testing.T) {
+			for _, valid := range []string{
+				test.prefix + testUUID,
+				test.prefix + "C1644B5B-3CA4-45B4-97C6-A2A0DE70D469",
+			} {
+				if err := test.parse(valid); err != nil {
+					t.Errorf("parsing %q: %v", valid, err)
+				}
+				if err := test.decode([]byte(`"` + valid + `"`)); err != nil {
+					t.Errorf("decoding %q: %v", valid, err)
+				}
+			}
+			if err := test.decode([]byte(`""`)); err != nil {
+				t.Errorf("decoding an empty id: %v", err)
+			}
+
+			wrongPrefix := "xyz_"
+			if test.prefix == "usr_" {
+				wrongPrefix = "wrld_"
+			} else if test.prefix != "wrld_" {
+				wrongPrefix = "usr_"
+			}
+			for _, invalid := range []string{
+				"",
+				testUUID,
+				wrongPrefix + testUUID,
+				test.prefix,
+				test.prefix + testUUID[:35],
+				test.prefix + testUUID + "0",
+				test.prefix + "c1644b5b_3ca4_45b4_97c6_a2a0de70d469",
+				test.prefix + "g1644b5b-3ca4-45b4-97c6-a2a0de70d469",
+				" " + test.prefix + testUUID,
+			} {
+				if err := test.parse(invalid); !errors.Is(err, ErrInvalidId) {
+					t.Errorf("parsing %q = %v, want ErrInvalidId", invalid, err)
+				}
+				if invalid == "" {
+					continue
+				}
+				if err := test.decode([]byte(`"` + invalid + `"`)); !errors.Is(err, ErrInvalidId) {
+					t.Errorf("decoding %q = %v, want ErrInvalidId", invalid, err)
+				}
+			}
+		})
+	}
+}
+
+func TestLegacyUserId(t *testing.T) {
+	for _, id := range []string{"8JoV9XEdpo", "0123456789"} {
+		if _, err := ParseUserId(id); err != nil {
+			t.Errorf("ParseUserId(%q): %v", id, err)
+		}
+	}
+	for _, id := range []string{"8JoV9XEdp", "8JoV9XEdpoo", "8JoV9XEd-o", "usr_8JoV9XEdpo"} {
+		if _, err := ParseUserId(id); !errors.Is(err, ErrInvalidId) {
+			t.Errorf("ParseUserId(%q) = %v, want ErrInvalidId", id, err)
+		}
+	}
+}
+
+func TestWorldIdLocations(t *testing.T) {
+	for _, location := range []string{LocationOffline, LocationPrivate, LocationTraveling} {
+		if _, err := ParseWorldId(location); !errors.Is(err, ErrInvalidId) {
+			t.Errorf("ParseWorldId(%q) = %v, want ErrInvalidId", location, err)
+		}
+		var id WorldId
+		if err := json.Unmarshal([]byte(`"`+location+`"`), &id); err != nil || string(id) != location {
+			t.Errorf("decoding %q = %q, %v", location, id, err)
+		}
+	}
+	var id WorldId
+	if err := json.Unmarshal([]byte(`"hidden"`), &id); !errors.Is(err, ErrInvalidId) {
+		t.Errorf("decoding hidden = %v, want ErrInvalidId", err)
+	}
+}
+
+func TestDecodeResponseIds(t *testing.T) {
+	var user LimitedUserFriend
+	data := `{"id":"usr_` + testUUID + `","displayName":"test","worldId":"","location":"offline"}`
+	if err := json.Unmarshal([]byte(data), &user); err != nil {
+		t.Errorf("decoding a user: %v", err)
+	}
+	if err := json.Unmarshal([]byte(`{"id":"avtr_`+testUUID+`"}`), &user); !errors.Is(err, ErrInvalidId) {
+		t.Errorf("decoding a user with an avatar id = %v, want ErrInvalidId", err)
+	}
+}
@@ -0,0 +1,248 @@
+package vrchat
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Locations of users who are not in an instance the current user can see.
+const (
+	LocationOffline   = "offline"
+	LocationPrivate   = "private"
+	LocationTraveling = "traveling"
+)
+
+// locationTypeTag stands for the tag holding the instance type and owner, e.g. hidden(usr_...),
+// no other tag has an empty name. locationOtherTag stands for the next of the tags kept in
+// Location.Tags, no tag name can hold a ~.
+const (
+	locationTypeTag  = ""
+	locationOtherTag = "~"
+)
+
+// locationTagOrder is the order the API writes the tags of an instance in.
+var locationTagOrder = []string{locationTypeTag, "groupAccessType", "canRequestInvite", "strict", "region", "nonce"}
+
+// Location is a parsed LocationId such as
+// wrld_4432ea9b-729c-46e3-8eaf-846aa0a37fdd:12345~hidden(usr_c1644b5b-3ca4-45b4-97c6-a2a0de70d469)~region(eu)~nonce(...),
+// made of a world, an instance name and the tags describing the instance.
+type Location struct {
+	// Special is LocationOffline, LocationPrivate or LocationTraveling for a user whose
+	// instance is not known, all other fields are empty then.
+	Special string
+
+	WorldId WorldId
+	// Name is the name of the instance, usually a number. It is empty for a location
+	// that only names a world.
+	Name string
+	// Type is the access type of the instance, InstanceTypePublic when it has no type tag.
+	Type InstanceType
+	// Owner is the user owning a hidden, friends or private instance, or the group
+	// owning a group instance.
+	Owner InstanceOwnerId
+	// GroupAccessType is the access type of a group instance.
+	GroupAccessType GroupAccessType
+	// CanRequestInvite is set for private instances whose members can be asked for an invite (invite+).
+	CanRequestInvite bool
+	// Strict restricts the instance to users who could join its owner.
+	Strict bool
+	Region InstanceRegion
+	Nonce  string
+	// Tags holds the tags this package does not know and the repetitions of those
+	// it knows, without their leading ~.
+	Tags []string
+
+	// order holds the names of the parsed tags, so String keeps their order
+	// and repetitions
+	order []string
+}
+
+// ParseLocation parses a location or an instance id prefixed with its world.
+func ParseLocation(s string) (Location, error) {
+	switch s {
+	case LocationOffline, LocationPrivate, LocationTraveling:
+		return Location{Special: s}, nil
+	case "":
+		return Location{}, nil
+	}
+
+	var l Location
+	world, instance, found := strings.Cut(s, ":")
+	l.WorldId = WorldId(world)
+	if world == "" {
+		return Location{}, fmt.Errorf("vrchat: location %q has no world", s)
+	}
+	if !found {
+		return l, nil
+	}
+
+	tags := strings.Split(instance, "~")
+	l.Name = tags[0]
+	if l.Name == "" {
+		return Location{}, fmt.Errorf("vrchat: location %q has no instance name", s)
+	}
+	l.Type = InstanceTypePublic
+	for _, tag := range tags[1:] {
+		name, value, hasValue, err := parseLocationTag(tag)
+		if err != nil {
+			return Location{}, fmt.Errorf("vrchat: location %q: %w", s, err)
+		}
+		seen := name
+		if hasValue && isInstanceType(name) {
+			seen = locationTypeTag
+		}
+		if slices.Contains(l.order, seen) {
+			// Only the first of repeated tags is parsed, the others are kept as they are
+			hasValue, name = false, locationOtherTag
+		}
+		switch {
+		case hasValue && isInstanceType(name):
+			l.Type = InstanceType(name)
+			l.Owner = InstanceOwnerId(value)
+			name = locationTypeTag
+		case hasValue && name == "groupAccessType":
+			l.GroupAccessType = GroupAccessType(value)
+		case hasValue && name == "region":
+			l.Region = InstanceRegion(value)
+		case hasValue && name == "nonce":
+			l.Nonce = value
+		case !hasValue && name == "canRequestInvite":
+			l.CanRequestInvite = true
+		case !hasValue && name == "strict":
+			l.Strict = true
+		default:
+			l.Tags = append(l.Tags, tag)
+			name = locationOtherTag
+		}
+		l.order = append(l.order, name)
+	}
+	return l, nil
+}
+
+// isInstanceType reports whether name is the name of a tag holding the instance type and owner.
+func isInstanceType(name string) bool {
+	switch InstanceType(name) {
+	case InstanceTypeHidden, InstanceTypeFriends, InstanceTypePrivate, InstanceTypeGroup:
+		return true
+	}
+	return false
+}
+
+// parseLocationTag splits a tag such as region(eu) into its name and value.
+func parseLocationTag(tag string) (name, value string, hasValue bool, err error) {
+	name, value, hasValue = strings.Cut(tag, "(")
+	if name == "" {
+		return "", "", false, fmt.Errorf("empty tag %q", tag)
+	}
+	if !hasValue {
+		return name, "", false, nil
+	}
+	value, ok := strings.CutSuffix(value, ")")
+	if !ok || strings.ContainsAny(value, "()") {
+		return "", "", false, fmt.Errorf("malformed tag %q", tag)
+	}
+	return name, value, true, nil
+}
+
+// String formats the location the way the API does.
+func (l Location) String() string {
+	if l.Special != "" {
+		return l.Special
+	}
+	if l.Name == "" {
+		return string(l.WorldId)
+	}
+	return string(l.WorldId) + ":" + string(l.InstanceId())
+}
+
+// InstanceId returns the instance part of the location, its name followed by its tags.
+// The tags of a parsed location keep their order, the others follow in the order the API uses.
+func (l Location) InstanceId() InstanceId {
+	if l.Special != "" {
+		return InstanceId(l.Special)
+	}
+
+	var b strings.Builder
+	b.WriteString(l.Name)
+	written := make(map[string]bool)
+	others := 0
+	for _, name := range l.order {
+		if name == locationOtherTag {
+			if others < len(l.Tags) {
+				b.WriteString("~" + l.Tags[others])
+				others++
+			}
+			continue
+		}
+		if tag, ok := l.tag(name); ok {
+			b.WriteString("~" + tag)
+			written[name] = true
+		}
+	}
+	for _, name := range locationTagOrder {
+		if tag, ok := l.tag(name); ok && !written[name] {
+			b.WriteString("~" + tag)
+		}
+	}
+	for _, tag := range l.Tags[others:] {
+		b.WriteString("~" + tag)
+	}
+	return InstanceId(b.String())
+}
+
+// tag formats the tag called name, if the location has it.
+func (l Location) tag(name string) (string, bool) {
+	switch name {
+	case locationTypeTag:
+		if l.Type != "" && l.Type != InstanceTypePublic {
+			return fmt.Sprintf("%s(%s)", l.Type, l.Owner), true
+		}
+	case "groupAccessType":
+		if l.GroupAccessType != "" {
+			return fmt.Sprintf("groupAccessType(%s)", l.GroupAccessType), true
+		}
+	case "canRequestInvite":
+		if l.CanRequestInvite {
+			return "canRequestInvite", true
+		}
+	case "strict":
+		if l.Strict {
+			return "strict", true
+		}
+	case "region":
+		if l.Region != "" {
+			return fmt.Sprintf("region(%s)", l.Region), true
+		}
+	case "nonce":
+		if l.Nonce != "" {
+			return fmt.Sprintf("nonce(%s)", l.Nonce), true
+		}
+	}
+	return "", false
+}
+
+// LocationId returns the location formatted as a LocationId.
+func (l Location) LocationId() LocationId {
+	return LocationId(l.String())
+}
+
+// MarshalText formats the location like String.
+func (l Location) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+// UnmarshalText parses the location like ParseLocation.
+func (l *Location) UnmarshalText(text []byte) error {
+	location, err := ParseLocation(string(text))
+	if err != nil {
+		return err
+	}
+	*l = location
+	return nil
+}
+
+// Location parses the location id.
+func (id LocationId) Location() (Location, error) {
+	return ParseLocation(string(id))
+}
@@ -0,0 +1,96 @@
+package vrchat
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLocationRoundTrip(t *testing.T) {
+	const (
+		world = "wrld_4432ea9b-729c-46e3-8eaf-846aa0a37fdd"
+		user  = "usr_c1644b5b-3ca4-45b4-97c6-a2a0de70d469"
+		group = "grp_71a7ff59-112c-4e78-a990-c7cc650776e5"
+	)
+	tests := []struct {
+		location string
+		want     Location
+		// parsed is set when only parsing keeps the order of the tags
+		parsed bool
+	}{
+		{location: LocationOffline, want: Location{Special: LocationOffline}},
+		{location: LocationPrivate, want: Location{Special: LocationPrivate}},
+		{location: LocationTraveling, want: Location{Special: LocationTraveling}},
+		{location: world, want: Location{WorldId: world}},
+		{location: world + ":12345", want: Location{WorldId: world, Name: "12345", Type: InstanceTypePublic}},
+		{location: world + ":12345~hidden(" + user + ")", want: Location{WorldId: world, Name: "12345", Type: InstanceTypeHidden, Owner: user}},
+		{location: world + ":12345~friends(" + user + ")", want: Location{WorldId: world, Name: "12345", Type: InstanceTypeFriends, Owner: user}},
+		{location: world + ":12345~private(" + user + ")", want: Location{WorldId: world, Name: "12345", Type: InstanceTypePrivate, Owner: user}},
+		{
+			location: world + ":12345~private(" + user + ")~canRequestInvite",
+			want:     Location{WorldId: world, Name: "12345", Type: InstanceTypePrivate, Owner: user, CanRequestInvite: true},
+		},
+		{
+			location: world + ":12345~group(" + group + ")~groupAccessType(plus)",
+			want:     Location{WorldId: world, Name: "12345", Type: InstanceTypeGroup, Owner: group, GroupAccessType: GroupAccessTypePlus},
+		},
+		{location: world + ":12345~region(eu)", want: Location{WorldId: world, Name: "12345", Type: InstanceTypePublic, Region: InstanceRegionEu}},
+		{
+			location: world + ":12345~hidden(" + user + ")~nonce(3c9ec1a2-4a9b-41ab-bd65-87bd8bd1ee6b)",
+			want:     Location{WorldId: world, Name: "12345", Type: InstanceTypeHidden, Owner: user, Nonce: "3c9ec1a2-4a9b-41ab-bd65-87bd8bd1ee6b"},
+		},
+		{
+			location: world + ":12345~friends(" + user + ")~strict",
+			want:     Location{WorldId: world, Name: "12345", Type: InstanceTypeFriends, Owner: user, Strict: true},
+		},
+		{
+			location: world + ":12345~region(jp)~hidden(" + user + ")~strict",
+			want:     Location{WorldId: world, Name: "12345", Type: InstanceTypeHidden, Owner: user, Strict: true, Region: InstanceRegionJp},
+			parsed:   true,
+		},
+		{
+			location: world + ":12345~foo~region(us)~foo~bar(1)",
+			want:     Location{WorldId: world, Name: "12345", Type: InstanceTypePublic, Region: InstanceRegionUs, Tags: []string{"foo", "foo", "bar(1)"}},
+			parsed:   true,
+		},
+		{
+			location: world + ":12345~region(eu)~hidden(" + user + ")~region(us)~friends(" + user + ")~strict~strict",
+			want: Location{
+				WorldId: world, Name: "12345", Type: InstanceTypeHidden, Owner: user, Strict: true, Region: InstanceRegionEu,
+				Tags: []string{"region(us)", "friends(" + user + ")", "strict"},
+			},
+			parsed: true,
+		},
+	}
+	for _, test := range tests {
+		l, err := ParseLocation(test.location)
+		if err != nil {
+			t.Errorf("ParseLocation(%q): %v", test.location, err)
+			continue
+		}
+		if got := l.String(); got != test.location {
+			t.Errorf("ParseLocation(%q).String() = %q", test.location, got)
+		}
+		if !test.parsed {
+			if got := test.want.String(); got != test.location {
+				t.Errorf("%+v.String() = %q, want %q", test.want, got, test.location)
+			}
+		}
+		l.order = nil
+		if !reflect.DeepEqual(l, test.want) {
+			t.Errorf("ParseLocation(%q) = %+v, want %+v", test.location, l, test.want)
+		}
+	}
+}
+
+func TestParseLocationInvalid(t *testing.T) {
+	for _, location := range []string{
+		":12345",
+		"wrld_4432ea9b-729c-46e3-8eaf-846aa0a37fdd:",
+		"wrld_4432ea9b-729c-46e3-8eaf-846aa0a37fdd:12345~region(eu",
+		"wrld_4432ea9b-729c-46e3-8eaf-846aa0a37fdd:12345~~strict",
+	} {
+		if _, err := ParseLocation(location); err == nil {
+			t.Errorf("ParseLocation(%q) succeeded", location)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package vrchat
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Bodies logged with LogOptions.Bodies are cut after this many bytes.
+const maxLoggedBody = 2048
+
+// LogOptions configures the logging of WithSlog.
+type LogOptions struct {
+	// CallLevel is the level of successful calls.
+	CallLevel slog.Level
+	// RetryLevel is the level of responses that are retried, e.g. 429 Too Many Requests.
+	RetryLevel slog.Level
+	// ErrorLevel is the level of failed calls.
+	ErrorLevel slog.Level
+	// Bodies adds the response body to the records of failed calls.
+	Bodies bool
+}
+
+// DefaultLogOptions logs calls at debug level and failures as warnings.
+var DefaultLogOptions = LogOptions{
+	CallLevel:  slog.LevelDebug,
+	RetryLevel: slog.LevelInfo,
+	ErrorLevel: slog.LevelWarn,
+}
+
+// WithSlog logs every call of the client to logger. Records carry the operation,
+// method, path, status, attempt and duration. Authorization headers, session
+// cookies, OTP codes, passwords and email addresses are always redacted, this also
+// applies to resty's debug output and to messages resty logs itself unless WithLogger is given.
+func WithSlog(logger *slog.Logger, opts LogOptions) Option {
+	return func(o *options) {
+		o.slog = logger
+		o.middleware = append(o.middleware, slogMiddleware(logger, opts))
+	}
+}
+
+func slogMiddleware(logger *slog.Logger, opts LogOptions) Middleware {
+	return Middleware{
+		AfterResponse: func(ctx context.Context, info *RequestInfo, resp *resty.Response) {
+			status := resp.StatusCode()
+			switch {
+			case status >= 200 && status < 300:
+				logger.LogAttrs(ctx, opts.CallLevel, "vrchat: call", requestAttrs(info, status)...)
+			case status == 429 || status == 502 || status == 503:
+				logger.LogAttrs(ctx, opts.RetryLevel, "vrchat: throttled or unavailable", requestAttrs(info, status)...)
+			}
+		},
+		OnError: func(ctx context.Context, info *RequestInfo, err error) {
+			attrs := requestAttrs(info, 0)
+			if apiErr, ok := err.(*APIError); ok {
+				attrs = requestAttrs(info, apiErr.StatusCode)
+				if opts.Bodies {
+					attrs = append(attrs, slog.String("body", truncate(redactBody(apiErr.Body), maxLoggedBody)))
+				}
+			}
+			attrs = append(attrs, slog.String("error", redactBody(err.Error())))
+			logger.LogAttrs(ctx, opts.ErrorLevel, "vrchat: call failed", attrs...)
+		},
+	}
+}
+
+func requestAttrs(info *RequestInfo, status int) []slog.Attr {
+	attrs := []slog.Attr{
+		slog.String("operation", info.Operation),
+		slog.String("method", info.Method),
+		slog.String("path", info.Path),
+		slog.Int("attempt", info.Attempt),
+		slog.Duration("elapsed", info.Elapsed),
+	}
+	if status != 0 {
+		attrs = append(attrs, slog.Int("status", status))
+	}
+	return attrs
+}
+
+// slogLogger adapts a *slog.Logger to the logger interface of resty.
+type slogLogger struct {
+	logger *slog.Logger
+}
+
+func (l slogLogger) Errorf(format string, v ...any) {
+	l.logger.Error(redactBody(fmt.Sprintf(format, v...)))
+}
+
+func (l slogLogger) Warnf(format string, v ...any) {
+	l.logger.Warn(redactBody(fmt.Sprintf(format, v...)))
+}
+
+func (l slogLogger) Debugf(format string, v ...any) {
+	l.logger.Debug(redactBody(fmt.Sprintf(format, v...)))
+}
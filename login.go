@@ -0,0 +1,171 @@
+package vrchat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// TwoFactorMethod is a second factor VRChat can require to complete a login.
+type TwoFactorMethod string
+
+const (
+	// TwoFactorTOTP is a code of an authenticator app.
+	TwoFactorTOTP TwoFactorMethod = "totp"
+	// TwoFactorOTP is one of the recovery codes given when enabling 2FA.
+	TwoFactorOTP TwoFactorMethod = "otp"
+	// TwoFactorEmailOTP is a code VRChat sent to the email address of the account.
+	TwoFactorEmailOTP TwoFactorMethod = "emailOtp"
+)
+
+// ErrTwoFactorRejected is returned by CompleteLogin when the API did not accept the code.
+var ErrTwoFactorRejected = errors.New("vrchat: two-factor code rejected")
+
+// LoginResult is the outcome of Login.
+type LoginResult struct {
+	// User is the logged in user, it is nil while a second factor is required.
+	User *CurrentUser
+	// RequiresTwoFactorAuth lists the second factors accepted to finish the login
+	// with CompleteLogin, it is empty once logged in.
+	RequiresTwoFactorAuth []TwoFactorMethod
+}
+
+// Requires reports whether the login can be completed with method.
+func (r *LoginResult) Requires(method TwoFactorMethod) bool {
+	return slices.Contains(r.RequiresTwoFactorAuth, method)
+}
+
+// Login logs in with username and password. When the account has two-factor
+// authentication enabled the result lists the accepted factors instead of the user,
+// and one of them must be passed to CompleteLogin.
+//
+// With a SessionStore the stored session is resumed instead, the credentials are
+// only sent when there is no stored session or the API rejected it. With a TOTP
+// secret a required TOTP factor is completed without returning to the caller.
+func (c *Client) Login(username, password string) (*LoginResult, error) {
+	return c.LoginWithContext(context.Background(), username, password)
+}
+
+// LoginWithContext is like Login but sends the requests with ctx.
+func (c *Client) LoginWithContext(ctx context.Context, username, password string) (*LoginResult, error) {
+	ctx = withoutReauthentication(ctx)
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+
+	user, err := c.resumeSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if user != nil {
+		return &LoginResult{User: user}, nil
+	}
+	return c.login(ctx, username, password)
+}
+
+// login logs in with username and password, completing a required TOTP factor if c has a TOTP secret.
+func (c *Client) login(ctx context.Context, username, password string) (*LoginResult, error) {
+	// Fail before sending the credentials rather than after the API asked for a code
+	if c.totpErr != nil {
+		return nil, c.totpErr
+	}
+	// VRChat expects the credentials to be URL encoded before they are base64 encoded
+	req := c.newRequest(ctx, "GetCurrentUser", "/auth/user").
+		SetBasicAuth(url.QueryEscape(username), url.QueryEscape(password))
+	resp, err := c.send(req, resty.MethodGet, "/auth/user")
+	if err != nil {
+		return nil, fmt.Errorf("error sending request: %w", err)
+	}
+	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
+		return nil, newAPIError("GetCurrentUser", resp)
+	}
+
+	var pending struct {
+		RequiresTwoFactorAuth []TwoFactorMethod `json:"requiresTwoFactorAuth"`
+	}
+	if err := json.Unmarshal(resp.Body(), &pending); err != nil {
+		return nil, fmt.Errorf("error decoding login response: %w", err)
+	}
+	if len(pending.RequiresTwoFactorAuth) > 0 {
+		result := &LoginResult{RequiresTwoFactorAuth: pending.RequiresTwoFactorAuth}
+		if c.totpSecret == "" || !result.Requires(TwoFactorTOTP) {
+			return result, nil
+		}
+
+		code, err := c.totpCode(ctx)
+		if err != nil {
+			return nil, err
+		}
+		user, err := c.completeLogin(ctx, TwoFactorTOTP, code)
+		if err != nil {
+			return nil, err
+		}
+		return &LoginResult{User: user}, nil
+	}
+
+	user := new(CurrentUser)
+	if err := json.Unmarshal(resp.Body(), user); err != nil {
+		return nil, fmt.Errorf("error decoding login response: %w", err)
+	}
+	if err := c.loggedIn(ctx); err != nil {
+		return nil, err
+	}
+	return &LoginResult{User: user}, nil
+}
+
+// CompleteLogin finishes a Login that requires a second factor by verifying code
+// with the chosen method, and returns the logged in user.
+func (c *Client) CompleteLogin(method TwoFactorMethod, code string) (*CurrentUser, error) {
+	return c.CompleteLoginWithContext(context.Background(), method, code)
+}
+
+// CompleteLoginWithContext is like CompleteLogin but sends the requests with ctx.
+func (c *Client) CompleteLoginWithContext(ctx context.Context, method TwoFactorMethod, code string) (*CurrentUser, error) {
+	ctx = withoutReauthentication(ctx)
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+	return c.completeLogin(ctx, method, code)
+}
+
+// completeLogin verifies code with method and fetches the logged in user.
+func (c *Client) completeLogin(ctx context.Context, method TwoFactorMethod, code string) (*CurrentUser, error) {
+	var verified bool
+	switch method {
+	case TwoFactorTOTP:
+		result, err := c.Verify2FaWithContext(ctx, TwoFactorAuthCode{Code: code})
+		if err != nil {
+			return nil, err
+		}
+		verified = result.Verified
+	case TwoFactorOTP:
+		result, err := c.VerifyRecoveryCodeWithContext(ctx, TwoFactorAuthCode{Code: code})
+		if err != nil {
+			return nil, err
+		}
+		verified = result.Verified
+	case TwoFactorEmailOTP:
+		result, err := c.Verify2FaEmailCodeWithContext(ctx, TwoFactorEmailCode{Code: code})
+		if err != nil {
+			return nil, err
+		}
+		verified = result.Verified
+	default:
+		return nil, fmt.Errorf("vrchat: unknown two-factor method %q", method)
+	}
+	if !verified {
+		return nil, ErrTwoFactorRejected
+	}
+
+	user, err := c.GetCurrentUserWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.loggedIn(ctx); err != nil {
+		return nil, err
+	}
+	return (*CurrentUser)(user), nil
+}
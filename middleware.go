@@ -0,0 +1,102 @@
+package vrchat
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// RequestInfo describes a request sent by a Client method to its middleware.
+type RequestInfo struct {
+	// Operation is the name of the client method, e.g. "GetUser".
+	Operation string
+	// Method is the HTTP method.
+	Method string
+	// PathTemplate is the path before the parameters are filled in, e.g. "/users/{userId}".
+	PathTemplate string
+	// Path is the requested path.
+	Path string
+	// Attempt counts the attempts of the request, starting at 1. It grows with every
+	// retry and after logging in again.
+	Attempt int
+	// Start is the time the current attempt was sent.
+	Start time.Time
+	// Elapsed is the duration of the current attempt, it is set for AfterResponse and OnError.
+	Elapsed time.Duration
+}
+
+// Middleware observes or modifies the requests of a Client, see WithMiddleware.
+// Every field is optional.
+type Middleware struct {
+	// BeforeRequest is called before every attempt and may modify req, e.g. to add
+	// headers. Returning an error aborts the request with that error.
+	BeforeRequest func(ctx context.Context, info *RequestInfo, req *resty.Request) error
+	// AfterResponse is called for every response, including those that will be retried.
+	AfterResponse func(ctx context.Context, info *RequestInfo, resp *resty.Response)
+	// OnError is called when an attempt could not be sent, and when the final
+	// response of a request is unsuccessful, with the *APIError the method returns.
+	OnError func(ctx context.Context, info *RequestInfo, err error)
+}
+
+// requestInfoKey stores the *RequestInfo of a request in its context.
+type requestInfoKey struct{}
+
+// newRequest returns a request for the given operation, pathTemplate being its
+// path before the parameters are filled in.
+func (c *Client) newRequest(ctx context.Context, operation, pathTemplate string) *resty.Request {
+	info := &RequestInfo{Operation: operation, PathTemplate: pathTemplate}
+	return c.client.R().SetContext(context.WithValue(ctx, requestInfoKey{}, info))
+}
+
+// newTransferRequest is like newRequest for a file upload or download, which is sent
+// with the transfer client of c.
+func (c *Client) newTransferRequest(ctx context.Context, operation, pathTemplate string) *resty.Request {
+	info := &RequestInfo{Operation: operation, PathTemplate: pathTemplate}
+	return c.transferClient().R().SetContext(context.WithValue(ctx, requestInfoKey{}, info))
+}
+
+// requestInfo returns the description of req, created by newRequest.
+func requestInfo(req *resty.Request, method, path string) *RequestInfo {
+	info, ok := req.Context().Value(requestInfoKey{}).(*RequestInfo)
+	if !ok {
+		info = &RequestInfo{PathTemplate: path}
+	}
+	info.Method = method
+	info.Path = path
+	return info
+}
+
+func (c *Client) beforeRequest(ctx context.Context, info *RequestInfo, req *resty.Request) error {
+	info.Attempt++
+	info.Start = time.Now()
+	info.Elapsed = 0
+	for _, m := range c.middleware {
+		if m.BeforeRequest != nil {
+			if err := m.BeforeRequest(ctx, info, req); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Client) afterResponse(ctx context.Context, info *RequestInfo, resp *resty.Response) {
+	info.Elapsed = time.Since(info.Start)
+	for _, m := range c.middleware {
+		if m.AfterResponse != nil {
+			m.AfterResponse(ctx, info, resp)
+		}
+	}
+}
+
+func (c *Client) onError(ctx context.Context, info *RequestInfo, err error) {
+	if info.Elapsed == 0 {
+		info.Elapsed = time.Since(info.Start)
+	}
+	for _, m := range c.middleware {
+		if m.OnError != nil {
+			m.OnError(ctx, info, err)
+		}
+	}
+}
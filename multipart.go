@@ -0,0 +1,345 @@
+package vrchat
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// The upload methods below replace the generated ones, which cannot send a multipart/form-data body.
+
+// UploadImageRequest holds the form fields of UploadImage.
+type UploadImageRequest struct {
+	// Tag is icon, gallery, sticker, emoji or emojianimated.
+	Tag string
+	// Frames is the total number of frames of an emojianimated image (2-64).
+	Frames Optional[int64]
+	// FramesOverTime is the animation speed of an emojianimated image in frames per second (1-64).
+	FramesOverTime Optional[int64]
+	// AnimationStyle is the animation style of a sticker, for example "bats", it is required for emoji.
+	AnimationStyle string
+	// MaskTag is the mask of a sticker, for example "square", it is optional for emoji.
+	MaskTag string
+}
+
+// UploadPrintRequest holds the form fields of UploadPrint.
+type UploadPrintRequest struct {
+	// Timestamp is the time the image was captured, the current time when zero.
+	Timestamp time.Time
+	// Note is the caption of the image.
+	Note string
+	// WorldId is the id of the world the image was captured in.
+	WorldId string
+	// WorldName is the name of the world the image was captured in.
+	WorldName string
+}
+
+// imageExtensions names uploaded files after their detected content type.
+var imageExtensions = map[string]string{
+	"image/png":  ".png",
+	"image/jpeg": ".jpg",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
+// multipartBody is a multipart/form-data request body built in memory, so the request
+// can be sent again when it is retried.
+type multipartBody struct {
+	buf bytes.Buffer
+	w   *multipart.Writer
+	err error
+}
+
+func newMultipartBody() *multipartBody {
+	b := &multipartBody{}
+	b.w = multipart.NewWriter(&b.buf)
+	return b
+}
+
+// field adds a form field, empty values are left out.
+func (b *multipartBody) field(name, value string) {
+	if b.err != nil || value == "" {
+		return
+	}
+	b.err = b.w.WriteField(name, value)
+}
+
+// jsonField adds a form field holding v encoded as JSON.
+func (b *multipartBody) jsonField(name string, v any) {
+	if b.err != nil {
+		return
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		b.err = err
+		return
+	}
+	b.field(name, string(data))
+}
+
+// file adds the content of r as a file named after the form field and its content type.
+func (b *multipartBody) file(name string, r io.Reader) {
+	if b.err != nil {
+		return
+	}
+	if r == nil {
+		b.err = fmt.Errorf("vrchat: no %s to upload", name)
+		return
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		b.err = err
+		return
+	}
+	contentType := http.DetectContentType(data)
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name=%q; filename=%q`, name, name+imageExtensions[contentType]))
+	header.Set("Content-Type", contentType)
+	part, err := b.w.CreatePart(header)
+	if err != nil {
+		b.err = err
+		return
+	}
+	_, b.err = part.Write(data)
+}
+
+// apply closes the body and sets it on req.
+func (b *multipartBody) apply(req *resty.Request) error {
+	if b.err != nil {
+		return b.err
+	}
+	if err := b.w.Close(); err != nil {
+		return err
+	}
+	req.SetHeader("Content-Type", b.w.FormDataContentType())
+	req.SetBody(b.buf.Bytes())
+	return nil
+}
+
+// imageFile returns a multipartBody holding the image read from r as the given form field.
+func imageFile(name string, r io.Reader) *multipartBody {
+	b := newMultipartBody()
+	b.file(name, r)
+	return b
+}
+
+// formInt formats an optional number form field, an unset value is left out.
+func formInt(o Optional[int64]) string {
+	if v, ok := o.Get(); ok {
+		return fmt.Sprint(v)
+	}
+	return ""
+}
+
+// UploadImage uploads an icon, gallery image, sticker or emoji read from file.
+func (c *Client) UploadImage(file io.Reader, body UploadImageRequest) (*FileResponse, error) {
+	return c.UploadImageWithContext(context.Background(), file, body)
+}
+
+// UploadImageWithContext is like UploadImage but sends the request with ctx.
+func (c *Client) UploadImageWithContext(ctx context.Context, file io.Reader, body UploadImageRequest) (*FileResponse, error) {
+	path := "/file/image"
+
+	// Create request
+	req := c.newRequest(ctx, "UploadImage", "/file/image")
+	// Set request body
+	form := imageFile("file", file)
+	form.field("tag", body.Tag)
+	form.field("frames", formInt(body.Frames))
+	form.field("framesOverTime", formInt(body.FramesOverTime))
+	form.field("animationStyle", body.AnimationStyle)
+	form.field("maskTag", body.MaskTag)
+	if err := form.apply(req); err != nil {
+		return nil, fmt.Errorf("error building request: %w", err)
+	}
+	// Set response object
+	var result FileResponse
+	req.SetResult(&result)
+
+	// Send request
+	resp, err := c.send(req, resty.MethodPost, path)
+	if err != nil {
+		return nil, fmt.Errorf("error sending request: %w", err)
+	}
+
+	// Check for successful status code
+	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
+		return nil, newAPIError("UploadImage", resp)
+	}
+	return &result, nil
+}
+
+// UploadIcon uploads an icon read from file.
+func (c *Client) UploadIcon(file io.Reader) (*FileResponse, error) {
+	return c.UploadIconWithContext(context.Background(), file)
+}
+
+// UploadIconWithContext is like UploadIcon but sends the request with ctx.
+func (c *Client) UploadIconWithContext(ctx context.Context, file io.Reader) (*FileResponse, error) {
+	return c.uploadFile(ctx, "UploadIcon", "/icon", file)
+}
+
+// UploadGalleryImage uploads a gallery image read from file.
+func (c *Client) UploadGalleryImage(file io.Reader) (*FileResponse, error) {
+	return c.UploadGalleryImageWithContext(context.Background(), file)
+}
+
+// UploadGalleryImageWithContext is like UploadGalleryImage but sends the request with ctx.
+func (c *Client) UploadGalleryImageWithContext(ctx context.Context, file io.Reader) (*FileResponse, error) {
+	return c.uploadFile(ctx, "UploadGalleryImage", "/gallery", file)
+}
+
+// uploadFile posts file as the only form field of a multipart request.
+func (c *Client) uploadFile(ctx context.Context, operation, path string, file io.Reader) (*FileResponse, error) {
+	// Create request
+	req := c.newRequest(ctx, operation, path)
+	// Set request body
+	if err := imageFile("file", file).apply(req); err != nil {
+		return nil, fmt.Errorf("error building request: %w", err)
+	}
+	// Set response object
+	var result FileResponse
+	req.SetResult(&result)
+
+	// Send request
+	resp, err := c.send(req, resty.MethodPost, path)
+	if err != nil {
+		return nil, fmt.Errorf("error sending request: %w", err)
+	}
+
+	// Check for successful status code
+	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
+		return nil, newAPIError(operation, resp)
+	}
+	return &result, nil
+}
+
+// UploadPrint uploads a print read from image.
+func (c *Client) UploadPrint(image io.Reader, body UploadPrintRequest) (*PrintResponse, error) {
+	return c.UploadPrintWithContext(context.Background(), image, body)
+}
+
+// UploadPrintWithContext is like UploadPrint but sends the request with ctx.
+func (c *Client) UploadPrintWithContext(ctx context.Context, image io.Reader, body UploadPrintRequest) (*PrintResponse, error) {
+	path := "/prints"
+
+	// Create request
+	req := c.newRequest(ctx, "UploadPrint", "/prints")
+	// Set request body
+	form := imageFile("image", image)
+	timestamp := body.Timestamp
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+	form.field("timestamp", timestamp.UTC().Format(time.RFC3339))
+	form.field("note", body.Note)
+	form.field("worldId", body.WorldId)
+	form.field("worldName", body.WorldName)
+	if err := form.apply(req); err != nil {
+		return nil, fmt.Errorf("error building request: %w", err)
+	}
+	// Set response object
+	var result PrintResponse
+	req.SetResult(&result)
+
+	// Send request
+	resp, err := c.send(req, resty.MethodPost, path)
+	if err != nil {
+		return nil, fmt.Errorf("error sending request: %w", err)
+	}
+
+	// Check for successful status code
+	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
+		return nil, newAPIError("UploadPrint", resp)
+	}
+	return &result, nil
+}
+
+// InviteUserWithPhoto invites a user, attaching the photo read from image.
+func (c *Client) InviteUserWithPhoto(params InviteUserWithPhotoParams, image io.Reader, body InviteRequest) (*SendNotificationResponse, error) {
+	return c.InviteUserWithPhotoWithContext(context.Background(), params, image, body)
+}
+
+// InviteUserWithPhotoWithContext is like InviteUserWithPhoto but sends the request with ctx.
+func (c *Client) InviteUserWithPhotoWithContext(ctx context.Context, params InviteUserWithPhotoParams, image io.Reader, body InviteRequest) (*SendNotificationResponse, error) {
+	path := "/invite/{userId}/photo"
+	// Replace path parameters
+	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
+
+	var result SendNotificationResponse
+	if err := c.sendPhoto(ctx, "InviteUserWithPhoto", "/invite/{userId}/photo", path, image, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RequestInviteWithPhoto requests an invite from a user, attaching the photo read from image.
+func (c *Client) RequestInviteWithPhoto(params RequestInviteWithPhotoParams, image io.Reader, body RequestInviteRequest) (*NotificationResponse, error) {
+	return c.RequestInviteWithPhotoWithContext(context.Background(), params, image, body)
+}
+
+// RequestInviteWithPhotoWithContext is like RequestInviteWithPhoto but sends the request with ctx.
+func (c *Client) RequestInviteWithPhotoWithContext(ctx context.Context, params RequestInviteWithPhotoParams, image io.Reader, body RequestInviteRequest) (*NotificationResponse, error) {
+	path := "/requestInvite/{userId}/photo"
+	// Replace path parameters
+	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
+
+	var result NotificationResponse
+	if err := c.sendPhoto(ctx, "RequestInviteWithPhoto", "/requestInvite/{userId}/photo", path, image, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RespondInviteWithPhoto responds to an invite request, attaching the photo read from image.
+func (c *Client) RespondInviteWithPhoto(params RespondInviteWithPhotoParams, image io.Reader, body InviteResponse) (*NotificationResponse, error) {
+	return c.RespondInviteWithPhotoWithContext(context.Background(), params, image, body)
+}
+
+// RespondInviteWithPhotoWithContext is like RespondInviteWithPhoto but sends the request with ctx.
+func (c *Client) RespondInviteWithPhotoWithContext(ctx context.Context, params RespondInviteWithPhotoParams, image io.Reader, body InviteResponse) (*NotificationResponse, error) {
+	path := "/invite/{notificationId}/response/photo"
+	// Replace path parameters
+	path = strings.ReplaceAll(path, "{notificationId}", fmt.Sprintf("%v", params.NotificationId))
+
+	var result NotificationResponse
+	if err := c.sendPhoto(ctx, "RespondInviteWithPhoto", "/invite/{notificationId}/response/photo", path, image, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// sendPhoto posts image with data encoded as JSON in the data field, decoding the response into result.
+func (c *Client) sendPhoto(ctx context.Context, operation, template, path string, image io.Reader, data any, result any) error {
+	// Create request
+	req := c.newRequest(ctx, operation, template)
+	// Set request body
+	form := imageFile("image", image)
+	form.jsonField("data", data)
+	if err := form.apply(req); err != nil {
+		return fmt.Errorf("error building request: %w", err)
+	}
+	// Set response object
+	req.SetResult(result)
+
+	// Send request
+	resp, err := c.send(req, resty.MethodPost, path)
+	if err != nil {
+		return fmt.Errorf("error sending request: %w", err)
+	}
+
+	// Check for successful status code
+	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
+		return newAPIError(operation, resp)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package vrchat
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// Optional is a value that is either set or absent, the zero Optional is absent.
+//
+// Optional query parameters are only sent when they are set, so Some(false) and Some(0)
+// reach the API while an absent value leaves the parameter to its server-side default.
+//
+// The fields of the update request bodies (UpdateUserRequest, UpdateWorldRequest, ...) are
+// Optional too and encoded with omitzero: absent fields are left unchanged, Some(false) or
+// Some("") sets the zero value and Clear empties a list.
+type Optional[T any] struct {
+	value T
+	set   bool
+}
+
+// Some returns an Optional set to value.
+func Some[T any](value T) Optional[T] {
+	return Optional[T]{value: value, set: true}
+}
+
+// Clear returns an Optional set to the zero value of T, a list is encoded as [] rather than null.
+func Clear[T any]() Optional[T] {
+	var zero T
+	return Some(zero)
+}
+
+// Get returns the value and whether it is set.
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.set
+}
+
+// IsSet reports whether the value is set.
+func (o Optional[T]) IsSet() bool {
+	return o.set
+}
+
+// IsZero reports whether the value is absent, it makes omitzero drop unset fields.
+func (o Optional[T]) IsZero() bool {
+	return !o.set
+}
+
+// Or returns the value if it is set and fallback otherwise.
+func (o Optional[T]) Or(fallback T) T {
+	if !o.set {
+		return fallback
+	}
+	return o.value
+}
+
+func (o Optional[T]) String() string {
+	if !o.set {
+		return "<unset>"
+	}
+	return fmt.Sprint(o.value)
+}
+
+// MarshalJSON encodes the value, or null when it is absent.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.set {
+		return []byte("null"), nil
+	}
+	if v := reflect.ValueOf(o.value); v.Kind() == reflect.Slice && v.IsNil() {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON decodes the value, null leaves it absent.
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*o = Optional[T]{}
+		return nil
+	}
+	if err := json.Unmarshal(data, &o.value); err != nil {
+		return err
+	}
+	o.set = true
+	return nil
+}
@@ -0,0 +1,84 @@
+package vrchat
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestOptionalQueryParams(t *testing.T) {
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte("[]"))
+	}))
+	defer server.Close()
+	c := NewClient(server.URL, "test", WithRateLimiter(nil), WithRetryPolicy(RetryPolicy{}))
+
+	tests := []struct {
+		name   string
+		params SearchAvatarsParams
+		query  string
+	}{
+		{"none", SearchAvatarsParams{}, ""},
+		{"zero values", SearchAvatarsParams{Featured: Some(false), N: Some[int64](0), Offset: Some[int64](0)}, "featured=false&n=0&offset=0"},
+		{"values", SearchAvatarsParams{Featured: Some(true), N: Some[int64](10)}, "featured=true&n=10"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := c.SearchAvatars(test.params); err != nil {
+				t.Fatalf("SearchAvatars: %v", err)
+			}
+			if query != test.query {
+				t.Errorf("SearchAvatars sent the query %q, want %q", query, test.query)
+			}
+		})
+	}
+}
+
+func TestOptionalPatchBody(t *testing.T) {
+	var body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		body = string(data)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(testUser))
+	}))
+	defer server.Close()
+	c := NewClient(server.URL, "test", WithRateLimiter(nil), WithRetryPolicy(RetryPolicy{}))
+
+	tests := []struct {
+		name    string
+		request UpdateUserRequest
+		body    string
+	}{
+		{"none", UpdateUserRequest{}, `{}`},
+		{"zero values", UpdateUserRequest{IsBoopingEnabled: Some(false), AcceptedTosVersion: Some[int64](0), Bio: Some("")},
+			`{"acceptedTOSVersion":0,"bio":"","isBoopingEnabled":false}`},
+		{"cleared list", UpdateUserRequest{BioLinks: Clear[[]string]()}, `{"bioLinks":[]}`},
+		{"values", UpdateUserRequest{IsBoopingEnabled: Some(true), BioLinks: Some([]string{"https://vrchat.com"})},
+			`{"bioLinks":["https://vrchat.com"],"isBoopingEnabled":true}`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := UpdateUserParams{UserId: "usr_c1644b5b-3ca4-45b4-97c6-a2a0de70d469"}
+			if _, err := c.UpdateUser(params, test.request); err != nil {
+				t.Fatalf("UpdateUser: %v", err)
+			}
+			if body != test.body {
+				t.Errorf("UpdateUser sent the body %s, want %s", body, test.body)
+			}
+
+			var decoded UpdateUserRequest
+			if err := json.Unmarshal([]byte(body), &decoded); err != nil {
+				t.Fatalf("Unmarshal: %v", err)
+			}
+			if again, _ := json.Marshal(decoded); string(again) != test.body {
+				t.Errorf("the decoded body encodes as %s, want %s", again, test.body)
+			}
+		})
+	}
+}
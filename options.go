@@ -0,0 +1,187 @@
+package vrchat
+
+import (
+	"log/slog"
+	"net/http"
+	"net/http/cookiejar"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"golang.org/x/net/publicsuffix"
+)
+
+// Option configures a Client created by NewClient.
+type Option func(*options)
+
+type options struct {
+	timeout     time.Duration
+	httpClient  *http.Client
+	transport   http.RoundTripper
+	proxy       string
+	retry       RetryPolicy
+	limiter     *RateLimiter
+	logger      resty.Logger
+	jar         http.CookieJar
+	headers     map[string]string
+	sessions    SessionStore
+	totpSecret  string
+	credentials CredentialsProvider
+	middleware  []Middleware
+	slog        *slog.Logger
+}
+
+// WithTimeout sets the timeout of every request, including retries and redirects.
+// File uploads and downloads are not covered, they are only bounded by their context.
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.timeout = timeout
+	}
+}
+
+// WithHTTPClient sends requests with a copy of httpClient, which is not modified and
+// may be shared by several clients. Its timeout is kept unless WithTimeout is given,
+// its cookie jar is replaced by a new one unless WithCookieJar is given. File transfers
+// use a copy without the timeout.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(o *options) {
+		o.httpClient = httpClient
+	}
+}
+
+// WithTransport sends requests with transport instead of the default transport.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(o *options) {
+		o.transport = transport
+	}
+}
+
+// WithProxy sends requests through the proxy at proxyURL.
+func WithProxy(proxyURL string) Option {
+	return func(o *options) {
+		o.proxy = proxyURL
+	}
+}
+
+// WithRetryPolicy replaces DefaultRetryPolicy, the zero RetryPolicy disables retrying.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *options) {
+		o.retry = policy
+	}
+}
+
+// WithRateLimiter paces requests with limiter, nil disables pacing.
+// A limiter can be shared by clients using the same account.
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(o *options) {
+		o.limiter = limiter
+	}
+}
+
+// WithLogger sets the logger resty reports warnings and errors to.
+func WithLogger(logger resty.Logger) Option {
+	return func(o *options) {
+		o.logger = logger
+	}
+}
+
+// WithCookieJar stores the session cookies in jar instead of a new in-memory jar.
+// Clients sharing a jar share their session.
+func WithCookieJar(jar http.CookieJar) Option {
+	return func(o *options) {
+		o.jar = jar
+	}
+}
+
+// WithHeader adds a header sent with every request.
+func WithHeader(key, value string) Option {
+	return func(o *options) {
+		if o.headers == nil {
+			o.headers = make(map[string]string)
+		}
+		o.headers[key] = value
+	}
+}
+
+// WithSessionStore saves the session to store after every login, and lets Login
+// resume the stored session instead of logging in again while the API accepts it.
+func WithSessionStore(store SessionStore) Option {
+	return func(o *options) {
+		o.sessions = store
+	}
+}
+
+// WithTOTPSecret lets Login complete two-factor authentication by itself, generating
+// TOTP codes from the base32 secret shown when enabling 2FA on the account. An empty
+// secret disables it. If the secret is not valid base32 every login with credentials
+// fails with the error of ValidateTOTPSecret, which can check secrets from configuration
+// up front.
+func WithTOTPSecret(secret string) Option {
+	return func(o *options) {
+		o.totpSecret = secret
+	}
+}
+
+// WithCredentials makes the client log in again with the credentials of provider
+// when a request fails with 401 Unauthorized, and then send the request once more.
+// A required second factor is generated from the TOTP secret of WithTOTPSecret,
+// or asked from Credentials.TwoFactor.
+func WithCredentials(provider CredentialsProvider) Option {
+	return func(o *options) {
+		o.credentials = provider
+	}
+}
+
+// WithMiddleware adds m to the middleware of the client, which is called in the
+// order it was added for the requests of every client method.
+func WithMiddleware(m Middleware) Option {
+	return func(o *options) {
+		o.middleware = append(o.middleware, m)
+	}
+}
+
+// restyLogger returns the logger resty reports to, nil for its default logger.
+func (o *options) restyLogger() resty.Logger {
+	if o.logger != nil {
+		return o.logger
+	}
+	if o.slog != nil {
+		return slogLogger{o.slog}
+	}
+	return nil
+}
+
+// restyClient builds the resty client described by o. It configures a copy of the
+// http.Client of WithHTTPClient, which may be shared with other clients.
+func (o *options) restyClient() *resty.Client {
+	hc := new(http.Client)
+	if o.httpClient != nil {
+		copied := *o.httpClient
+		hc = &copied
+	}
+	if o.timeout != 0 {
+		hc.Timeout = o.timeout
+	}
+	if o.transport != nil {
+		hc.Transport = o.transport
+	}
+	// resty sets the proxy on the transport itself
+	if transport, ok := hc.Transport.(*http.Transport); ok && o.proxy != "" {
+		hc.Transport = transport.Clone()
+	}
+	// The session cookies must be kept between requests, in a jar of this client only
+	hc.Jar = o.jar
+	if hc.Jar == nil {
+		hc.Jar, _ = cookiejar.New(&cookiejar.Options{PublicSuffixList: publicsuffix.List})
+	}
+
+	client := resty.NewWithClient(hc)
+	if o.proxy != "" {
+		client.SetProxy(o.proxy)
+	}
+	if logger := o.restyLogger(); logger != nil {
+		client.SetLogger(logger)
+	}
+	redactDebugLogs(client)
+	client.SetHeaders(o.headers)
+	return client
+}
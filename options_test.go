@@ -0,0 +1,25 @@
+package vrchat
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestWithHTTPClientCopiesTheClient(t *testing.T) {
+	shared := &http.Client{Timeout: time.Minute}
+	a := NewClient("", "test", WithHTTPClient(shared), WithTimeout(time.Second), WithProxy("http://127.0.0.1:8080"))
+	b := NewClient("", "test", WithHTTPClient(shared))
+
+	if shared.Timeout != time.Minute || shared.Jar != nil || shared.Transport != nil {
+		t.Errorf("NewClient changed the shared client to %+v", shared)
+	}
+	if a.client.GetClient().Timeout != time.Second || b.client.GetClient().Timeout != time.Minute {
+		t.Errorf("timeouts are %v and %v, want 1s and 1m", a.client.GetClient().Timeout, b.client.GetClient().Timeout)
+	}
+
+	a.SetSession(&Session{Auth: "authcookie_a"})
+	if session := b.Session(); session != nil {
+		t.Errorf("a client sharing the http.Client sees the session %+v", session)
+	}
+}
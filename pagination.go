@@ -0,0 +1,539 @@
+package vrchat
+
+import (
+	"context"
+	"iter"
+)
+
+// MaxPageSize is the largest page size (the n query parameter) the API accepts on the list endpoints.
+const MaxPageSize = 100
+
+// pageSize returns n clamped to 1..MaxPageSize, a value of zero or less asks for full pages.
+func pageSize(n int64) int64 {
+	if n <= 0 || n > MaxPageSize {
+		return MaxPageSize
+	}
+	return n
+}
+
+// paginate yields every item of the pages returned by fetch, starting at offset with pages of n items.
+//
+// Pages are fetched lazily as the caller ranges over the sequence, a page shorter than n ends it.
+// An error from fetch or ctx is yielded once and ends the sequence.
+func paginate[T any](ctx context.Context, offset, n int64, fetch func(ctx context.Context, offset, n int64) ([]T, error)) iter.Seq2[T, error] {
+	n = pageSize(n)
+	offset = max(offset, 0)
+	return func(yield func(T, error) bool) {
+		for {
+			if err := ctx.Err(); err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			page, err := fetch(ctx, offset, n)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range page {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if int64(len(page)) < n {
+				return
+			}
+			offset += int64(len(page))
+		}
+	}
+}
+
+// AvatarsSearchAll iterates over every avatar matching params, see SearchAvatars.
+// params.Offset is the starting offset and params.N the page size.
+func (c *Client) AvatarsSearchAll(ctx context.Context, params SearchAvatarsParams) iter.Seq2[Avatar, error] {
+	return paginate(ctx, params.Offset.Or(0), params.N.Or(0), func(ctx context.Context, offset, n int64) ([]Avatar, error) {
+		params.Offset, params.N = Some(offset), Some(n)
+		page, err := c.SearchAvatarsWithContext(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		return *page, nil
+	})
+}
+
+// FavoritedAvatarsAll iterates over every favorited avatar, see GetFavoritedAvatars.
+// params.Offset is the starting offset and params.N the page size.
+func (c *Client) FavoritedAvatarsAll(ctx context.Context, params GetFavoritedAvatarsParams) iter.Seq2[Avatar, error] {
+	return paginate(ctx, params.Offset.Or(0), params.N.Or(0), func(ctx context.Context, offset, n int64) ([]Avatar, error) {
+		params.Offset, params.N = Some(offset), Some(n)
+		page, err := c.GetFavoritedAvatarsWithContext(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		return *page, nil
+	})
+}
+
+// LicensedAvatarsAll iterates over every licensed avatar, see GetLicensedAvatars.
+// params.Offset is the starting offset and params.N the page size.
+func (c *Client) LicensedAvatarsAll(ctx context.Context, params GetLicensedAvatarsParams) iter.Seq2[Avatar, error] {
+	return paginate(ctx, params.Offset.Or(0), params.N.Or(0), func(ctx context.Context, offset, n int64) ([]Avatar, error) {
+		params.Offset, params.N = Some(offset), Some(n)
+		page, err := c.GetLicensedAvatarsWithContext(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		return *page, nil
+	})
+}
+
+// ProductListingsAll iterates over every product listing of a user, see GetProductListings.
+// params.Offset is the starting offset and params.N the page size.
+func (c *Client) ProductListingsAll(ctx context.Context, params GetProductListingsParams) iter.Seq2[ProductListing, error] {
+	return paginate(ctx, params.Offset.Or(0), params.N.Or(0), func(ctx context.Context, offset, n int64) ([]ProductListing, error) {
+		params.Offset, params.N = Some(offset), Some(n)
+		page, err := c.GetProductListingsWithContext(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		return *page, nil
+	})
+}
+
+// FavoritesAll iterates over every favorite, see GetFavorites.
+// params.Offset is the starting offset and params.N the page size.
+func (c *Client) FavoritesAll(ctx context.Context, params GetFavoritesParams) iter.Seq2[Favorite, error] {
+	return paginate(ctx, params.Offset.Or(0), params.N.Or(0), func(ctx context.Context, offset, n int64) ([]Favorite, error) {
+		params.Offset, params.N = Some(offset), Some(n)
+		page, err := c.GetFavoritesWithContext(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		return *page, nil
+	})
+}
+
+// FavoriteGroupsAll iterates over every favorite group, see GetFavoriteGroups.
+// params.Offset is the starting offset and params.N the page size.
+func (c *Client) FavoriteGroupsAll(ctx context.Context, params GetFavoriteGroupsParams) iter.Seq2[FavoriteGroup, error] {
+	return paginate(ctx, params.Offset.Or(0), params.N.Or(0), func(ctx context.Context, offset, n int64) ([]FavoriteGroup, error) {
+		params.Offset, params.N = Some(offset), Some(n)
+		page, err := c.GetFavoriteGroupsWithContext(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		return *page, nil
+	})
+}
+
+// FilesAll iterates over every file, see GetFiles.
+// params.Offset is the starting offset and params.N the page size.
+func (c *Client) FilesAll(ctx context.Context, params GetFilesParams) iter.Seq2[File, error] {
+	return paginate(ctx, params.Offset.Or(0), params.N.Or(0), func(ctx context.Context, offset, n int64) ([]File, error) {
+		params.Offset, params.N = Some(offset), Some(n)
+		page, err := c.GetFilesWithContext(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		return *page, nil
+	})
+}
+
+// FriendsAll iterates over every friend, see GetFriends.
+// params.Offset is the starting offset and params.N the page size.
+func (c *Client) FriendsAll(ctx context.Context, params GetFriendsParams) iter.Seq2[LimitedUserFriend, error] {
+	return paginate(ctx, params.Offset.Or(0), params.N.Or(0), func(ctx context.Context, offset, n int64) ([]LimitedUserFriend, error) {
+		params.Offset, params.N = Some(offset), Some(n)
+		page, err := c.GetFriendsWithContext(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		return *page, nil
+	})
+}
+
+// GroupsSearchAll iterates over every group matching params, see SearchGroups.
+// params.Offset is the starting offset and params.N the page size.
+func (c *Client) GroupsSearchAll(ctx context.Context, params SearchGroupsParams) iter.Seq2[LimitedGroup, error] {
+	return paginate(ctx, params.Offset.Or(0), params.N.Or(0), func(ctx context.Context, offset, n int64) ([]LimitedGroup, error) {
+		params.Offset, params.N = Some(offset), Some(n)
+		page, err := c.SearchGroupsWithContext(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		return *page, nil
+	})
+}
+
+// GroupBansAll iterates over every banned member of a group, see GetGroupBans.
+// params.Offset is the starting offset and params.N the page size.
+func (c *Client) GroupBansAll(ctx context.Context, params GetGroupBansParams) iter.Seq2[GroupMember, error] {
+	return paginate(ctx, params.Offset.Or(0), params.N.Or(0), func(ctx context.Context, offset, n int64) ([]GroupMember, error) {
+		params.Offset, params.N = Some(offset), Some(n)
+		page, err := c.GetGroupBansWithContext(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		return *page, nil
+	})
+}
+
+// GroupGalleryImagesAll iterates over every image of a group gallery, see GetGroupGalleryImages.
+// params.Offset is the starting offset and params.N the page size.
+func (c *Client) GroupGalleryImagesAll(ctx context.Context, params GetGroupGalleryImagesParams) iter.Seq2[GroupGalleryImage, error] {
+	return paginate(ctx, params.Offset.Or(0), params.N.Or(0), func(ctx context.Context, offset, n int64) ([]GroupGalleryImage, error) {
+		params.Offset, params.N = Some(offset), Some(n)
+		page, err := c.GetGroupGalleryImagesWithContext(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		return *page, nil
+	})
+}
+
+// GroupInvitesAll iterates over every invited member of a group, see GetGroupInvites.
+// params.Offset is the starting offset and params.N the page size.
+func (c *Client) GroupInvitesAll(ctx context.Context, params GetGroupInvitesParams) iter.Seq2[GroupMember, error] {
+	return paginate(ctx, params.Offset.Or(0), params.N.Or(0), func(ctx context.Context, offset, n int64) ([]GroupMember, error) {
+		params.Offset, params.N = Some(offset), Some(n)
+		page, err := c.GetGroupInvitesWithContext(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		return *page, nil
+	})
+}
+
+// GroupMembersAll iterates over every member of a group, see GetGroupMembers.
+// params.Offset is the starting offset and params.N the page size.
+func (c *Client) GroupMembersAll(ctx context.Context, params GetGroupMembersParams) iter.Seq2[GroupMember, error] {
+	return paginate(ctx, params.Offset.Or(0), params.N.Or(0), func(ctx context.Context, offset, n int64) ([]GroupMember, error) {
+		params.Offset, params.N = Some(offset), Some(n)
+		page, err := c.GetGroupMembersWithContext(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		return *page, nil
+	})
+}
+
+// GroupPostsAll iterates over every post of a group, see GetGroupPosts.
+// params.Offset is the starting offset and params.N the page size.
+func (c *Client) GroupPostsAll(ctx context.Context, params GetGroupPostsParams) iter.Seq2[GroupPost, error] {
+	return paginate(ctx, params.Offset.Or(0), params.N.Or(0), func(ctx context.Context, offset, n int64) ([]GroupPost, error) {
+		params.Offset, params.N = Some(offset), Some(n)
+		page, err := c.GetGroupPostsWithContext(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		return page.Posts, nil
+	})
+}
+
+// GroupRequestsAll iterates over every member requesting to join a group, see GetGroupRequests.
+// params.Offset is the starting offset and params.N the page size.
+func (c *Client) GroupRequestsAll(ctx context.Context, params GetGroupRequestsParams) iter.Seq2[GroupMember, error] {
+	return paginate(ctx, params.Offset.Or(0), params.N.Or(0), func(ctx context.Context, offset, n int64) ([]GroupMember, error) {
+		params.Offset, params.N = Some(offset), Some(n)
+		page, err := c.GetGroupRequestsWithContext(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		return *page, nil
+	})
+}
+
+// InventoryAll iterates over every inventory item, see GetInventory.
+// params.Offset is the starting offset and params.N the page size.
+func (c *Client) InventoryAll(ctx context.Context, params GetInventoryParams) iter.Seq2[InventoryItem, error] {
+	return paginate(ctx, params.Offset.Or(0), params.N.Or(0), func(ctx context.Context, offset, n int64) ([]InventoryItem, error) {
+		params.Offset, params.N = Some(offset), Some(n)
+		page, err := c.GetInventoryWithContext(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		return page.Data, nil
+	})
+}
+
+// RecentLocationsAll iterates over every recently visited location, see GetRecentLocations.
+// params.Offset is the starting offset and params.N the page size.
+func (c *Client) RecentLocationsAll(ctx context.Context, params GetRecentLocationsParams) iter.Seq2[LocationId, error] {
+	return paginate(ctx, params.Offset.Or(0), params.N.Or(0), func(ctx context.Context, offset, n int64) ([]LocationId, error) {
+		params.Offset, params.N = Some(offset), Some(n)
+		page, err := c.GetRecentLocationsWithContext(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		return *page, nil
+	})
+}
+
+// NotificationsAll iterates over every notification, see GetNotifications.
+// params.Offset is the starting offset and params.N the page size.
+func (c *Client) NotificationsAll(ctx context.Context, params GetNotificationsParams) iter.Seq2[Notification, error] {
+	return paginate(ctx, params.Offset.Or(0), params.N.Or(0), func(ctx context.Context, offset, n int64) ([]Notification, error) {
+		params.Offset, params.N = Some(offset), Some(n)
+		page, err := c.GetNotificationsWithContext(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		return *page, nil
+	})
+}
+
+// UsersSearchAll iterates over every user matching params, see SearchUsers.
+// params.Offset is the starting offset and params.N the page size.
+func (c *Client) UsersSearchAll(ctx context.Context, params SearchUsersParams) iter.Seq2[LimitedUserSearch, error] {
+	return paginate(ctx, params.Offset.Or(0), params.N.Or(0), func(ctx context.Context, offset, n int64) ([]LimitedUserSearch, error) {
+		params.Offset, params.N = Some(offset), Some(n)
+		page, err := c.SearchUsersWithContext(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		return *page, nil
+	})
+}
+
+// UserFeedbackAll iterates over every feedback of a user, see GetUserFeedback.
+// params.Offset is the starting offset and params.N the page size.
+func (c *Client) UserFeedbackAll(ctx context.Context, params GetUserFeedbackParams) iter.Seq2[Feedback, error] {
+	return paginate(ctx, params.Offset.Or(0), params.N.Or(0), func(ctx context.Context, offset, n int64) ([]Feedback, error) {
+		params.Offset, params.N = Some(offset), Some(n)
+		page, err := c.GetUserFeedbackWithContext(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		return *page, nil
+	})
+}
+
+// UserNotesAll iterates over every user note, see GetUserNotes.
+// params.Offset is the starting offset and params.N the page size.
+func (c *Client) UserNotesAll(ctx context.Context, params GetUserNotesParams) iter.Seq2[UserNote, error] {
+	return paginate(ctx, params.Offset.Or(0), params.N.Or(0), func(ctx context.Context, offset, n int64) ([]UserNote, error) {
+		params.Offset, params.N = Some(offset), Some(n)
+		page, err := c.GetUserNotesWithContext(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		return *page, nil
+	})
+}
+
+// WorldsSearchAll iterates over every world matching params, see SearchWorlds.
+// params.Offset is the starting offset and params.N the page size.
+func (c *Client) WorldsSearchAll(ctx context.Context, params SearchWorldsParams) iter.Seq2[LimitedWorld, error] {
+	return paginate(ctx, params.Offset.Or(0), params.N.Or(0), func(ctx context.Context, offset, n int64) ([]LimitedWorld, error) {
+		params.Offset, params.N = Some(offset), Some(n)
+		page, err := c.SearchWorldsWithContext(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		return *page, nil
+	})
+}
+
+// ActiveWorldsAll iterates over every active world, see GetActiveWorlds.
+// params.Offset is the starting offset and params.N the page size.
+func (c *Client) ActiveWorldsAll(ctx context.Context, params GetActiveWorldsParams) iter.Seq2[LimitedWorld, error] {
+	return paginate(ctx, params.Offset.Or(0), params.N.Or(0), func(ctx context.Context, offset, n int64) ([]LimitedWorld, error) {
+		params.Offset, params.N = Some(offset), Some(n)
+		page, err := c.GetActiveWorldsWithContext(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		return *page, nil
+	})
+}
+
+// FavoritedWorldsAll iterates over every favorited world, see GetFavoritedWorlds.
+// params.Offset is the starting offset and params.N the page size.
+func (c *Client) FavoritedWorldsAll(ctx context.Context, params GetFavoritedWorldsParams) iter.Seq2[FavoritedWorld, error] {
+	return paginate(ctx, params.Offset.Or(0), params.N.Or(0), func(ctx context.Context, offset, n int64) ([]FavoritedWorld, error) {
+		params.Offset, params.N = Some(offset), Some(n)
+		page, err := c.GetFavoritedWorldsWithContext(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		return *page, nil
+	})
+}
+
+// RecentWorldsAll iterates over every recently visited world, see GetRecentWorlds.
+// params.Offset is the starting offset and params.N the page size.
+func (c *Client) RecentWorldsAll(ctx context.Context, params GetRecentWorldsParams) iter.Seq2[LimitedWorld, error] {
+	return paginate(ctx, params.Offset.Or(0), params.N.Or(0), func(ctx context.Context, offset, n int64) ([]LimitedWorld, error) {
+		params.Offset, params.N = Some(offset), Some(n)
+		page, err := c.GetRecentWorldsWithContext(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		return *page, nil
+	})
+}
+
+// Cursor walks a paginated result that reports whether more pages follow, like PaginatedCalendarEventList
+// and PaginatedGroupAuditLogEntryList.
+//
+// The first page is fetched when the Cursor is created so TotalCount is known before iterating.
+// Offset is the position of the next item, passing it as the Offset parameter later resumes where the
+// Cursor stopped. A Cursor is not safe for concurrent use.
+type Cursor[T any] struct {
+	ctx     context.Context
+	fetch   func(ctx context.Context, offset, n int64) (cursorPage[T], error)
+	offset  int64
+	n       int64
+	page    cursorPage[T]
+	fetched int
+}
+
+// cursorPage is one page of a paginated result.
+type cursorPage[T any] struct {
+	results    []T
+	hasNext    bool
+	totalCount int64
+}
+
+// newCursor creates a Cursor starting at offset with pages of n items and fetches its first page.
+func newCursor[T any](ctx context.Context, offset, n int64, fetch func(ctx context.Context, offset, n int64) (cursorPage[T], error)) (*Cursor[T], error) {
+	c := &Cursor[T]{ctx: ctx, fetch: fetch, offset: max(offset, 0), n: pageSize(n)}
+	if err := c.next(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// next fetches the page at the current offset.
+func (c *Cursor[T]) next() error {
+	if err := c.ctx.Err(); err != nil {
+		return err
+	}
+	page, err := c.fetch(c.ctx, c.offset, c.n)
+	if err != nil {
+		return err
+	}
+	c.page = page
+	c.fetched = len(page.results)
+	return nil
+}
+
+// TotalCount returns the total number of results reported by the last fetched page.
+func (c *Cursor[T]) TotalCount() int64 {
+	return c.page.totalCount
+}
+
+// Offset returns the position of the next item, use it as the Offset parameter to resume later.
+func (c *Cursor[T]) Offset() int64 {
+	return c.offset
+}
+
+// All iterates over the remaining items, fetching further pages while the API reports a next page.
+//
+// An error is yielded once and ends the sequence, ranging over All again retries from Offset.
+func (c *Cursor[T]) All() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for {
+			for len(c.page.results) > 0 {
+				item := c.page.results[0]
+				c.page.results = c.page.results[1:]
+				c.offset++
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if !c.page.hasNext || c.fetched == 0 {
+				return
+			}
+			if err := c.next(); err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+		}
+	}
+}
+
+// calendarPage converts a CalendarEventListResponse to a cursorPage.
+func calendarPage(resp *CalendarEventListResponse) cursorPage[CalendarEvent] {
+	return cursorPage[CalendarEvent]{results: resp.Results, hasNext: resp.HasNext, totalCount: resp.TotalCount}
+}
+
+// CalendarEventsCursor returns a Cursor over the calendar events of a month, see GetCalendarEvents.
+// params.Offset is the starting offset and params.N the page size.
+func (c *Client) CalendarEventsCursor(ctx context.Context, params GetCalendarEventsParams) (*Cursor[CalendarEvent], error) {
+	return newCursor(ctx, params.Offset.Or(0), params.N.Or(0), func(ctx context.Context, offset, n int64) (cursorPage[CalendarEvent], error) {
+		params.Offset, params.N = Some(offset), Some(n)
+		resp, err := c.GetCalendarEventsWithContext(ctx, params)
+		if err != nil {
+			return cursorPage[CalendarEvent]{}, err
+		}
+		return calendarPage(resp), nil
+	})
+}
+
+// FeaturedCalendarEventsCursor returns a Cursor over the featured calendar events, see GetFeaturedCalendarEvents.
+// params.Offset is the starting offset and params.N the page size.
+func (c *Client) FeaturedCalendarEventsCursor(ctx context.Context, params GetFeaturedCalendarEventsParams) (*Cursor[CalendarEvent], error) {
+	return newCursor(ctx, params.Offset.Or(0), params.N.Or(0), func(ctx context.Context, offset, n int64) (cursorPage[CalendarEvent], error) {
+		params.Offset, params.N = Some(offset), Some(n)
+		resp, err := c.GetFeaturedCalendarEventsWithContext(ctx, params)
+		if err != nil {
+			return cursorPage[CalendarEvent]{}, err
+		}
+		return calendarPage(resp), nil
+	})
+}
+
+// FollowedCalendarEventsCursor returns a Cursor over the followed calendar events, see GetFollowedCalendarEvents.
+// params.Offset is the starting offset and params.N the page size.
+func (c *Client) FollowedCalendarEventsCursor(ctx context.Context, params GetFollowedCalendarEventsParams) (*Cursor[CalendarEvent], error) {
+	return newCursor(ctx, params.Offset.Or(0), params.N.Or(0), func(ctx context.Context, offset, n int64) (cursorPage[CalendarEvent], error) {
+		params.Offset, params.N = Some(offset), Some(n)
+		resp, err := c.GetFollowedCalendarEventsWithContext(ctx, params)
+		if err != nil {
+			return cursorPage[CalendarEvent]{}, err
+		}
+		return calendarPage(resp), nil
+	})
+}
+
+// CalendarEventsSearchCursor returns a Cursor over the calendar events matching params, see SearchCalendarEvents.
+// params.Offset is the starting offset and params.N the page size.
+func (c *Client) CalendarEventsSearchCursor(ctx context.Context, params SearchCalendarEventsParams) (*Cursor[CalendarEvent], error) {
+	return newCursor(ctx, params.Offset.Or(0), params.N.Or(0), func(ctx context.Context, offset, n int64) (cursorPage[CalendarEvent], error) {
+		params.Offset, params.N = Some(offset), Some(n)
+		resp, err := c.SearchCalendarEventsWithContext(ctx, params)
+		if err != nil {
+			return cursorPage[CalendarEvent]{}, err
+		}
+		return calendarPage(resp), nil
+	})
+}
+
+// GroupCalendarEventsCursor returns a Cursor over the calendar events of a group, see GetGroupCalendarEvents.
+// params.Offset is the starting offset and params.N the page size.
+func (c *Client) GroupCalendarEventsCursor(ctx context.Context, params GetGroupCalendarEventsParams) (*Cursor[CalendarEvent], error) {
+	return newCursor(ctx, params.Offset.Or(0), params.N.Or(0), func(ctx context.Context, offset, n int64) (cursorPage[CalendarEvent], error) {
+		params.Offset, params.N = Some(offset), Some(n)
+		resp, err := c.GetGroupCalendarEventsWithContext(ctx, params)
+		if err != nil {
+			return cursorPage[CalendarEvent]{}, err
+		}
+		return calendarPage(resp), nil
+	})
+}
+
+// GroupAuditLogsCursor returns a Cursor over the audit log entries of a group, see GetGroupAuditLogs.
+// params.Offset is the starting offset and params.N the page size.
+func (c *Client) GroupAuditLogsCursor(ctx context.Context, params GetGroupAuditLogsParams) (*Cursor[GroupAuditLogEntry], error) {
+	return newCursor(ctx, params.Offset.Or(0), params.N.Or(0), func(ctx context.Context, offset, n int64) (cursorPage[GroupAuditLogEntry], error) {
+		params.Offset, params.N = Some(offset), Some(n)
+		resp, err := c.GetGroupAuditLogsWithContext(ctx, params)
+		if err != nil {
+			return cursorPage[GroupAuditLogEntry]{}, err
+		}
+		return cursorPage[GroupAuditLogEntry]{results: resp.Results, hasNext: resp.HasNext, totalCount: resp.TotalCount}, nil
+	})
+}
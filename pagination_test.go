@@ -0,0 +1,202 @@
+package vrchat
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"slices"
+	"testing"
+)
+
+// fakeList serves the items 0..total-1 a page at a time and records the requested offsets.
+type fakeList struct {
+	total   int64
+	offsets []int64
+	// failAt is the offset whose fetch fails with err
+	failAt int64
+	err    error
+}
+
+func (l *fakeList) fetch(ctx context.Context, offset, n int64) ([]int64, error) {
+	l.offsets = append(l.offsets, offset)
+	if l.err != nil && offset == l.failAt {
+		return nil, l.err
+	}
+	var page []int64
+	for i := offset; i < min(offset+n, l.total); i++ {
+		page = append(page, i)
+	}
+	return page, nil
+}
+
+// collect ranges over seq until it ends or limit items were seen, and returns the items and errors.
+func collect(seq iter.Seq2[int64, error], limit int) ([]int64, []error) {
+	var (
+		items []int64
+		errs  []error
+	)
+	for item, err := range seq {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		items = append(items, item)
+		if len(items) == limit {
+			break
+		}
+	}
+	return items, errs
+}
+
+func span(from, to int64) []int64 {
+	var items []int64
+	for i := from; i < to; i++ {
+		items = append(items, i)
+	}
+	return items
+}
+
+func TestPaginate(t *testing.T) {
+	tests := []struct {
+		name          string
+		total, offset int64
+		n             int64
+		limit         int
+		items         []int64
+		offsets       []int64
+	}{
+		{"short page", 25, 0, 10, 0, span(0, 25), []int64{0, 10, 20}},
+		{"empty last page", 20, 0, 10, 0, span(0, 20), []int64{0, 10, 20}},
+		{"from offset", 25, 12, 10, 0, span(12, 25), []int64{12, 22}},
+		{"negative offset", 5, -3, 10, 0, span(0, 5), []int64{0}},
+		{"default page size", 150, 0, 0, 0, span(0, 150), []int64{0, 100}},
+		{"consumer breaks", 25, 0, 10, 12, span(0, 12), []int64{0, 10}},
+		{"consumer breaks at page end", 25, 0, 10, 10, span(0, 10), []int64{0}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			l := &fakeList{total: test.total}
+			items, errs := collect(paginate(context.Background(), test.offset, test.n, l.fetch), test.limit)
+			if len(errs) > 0 {
+				t.Errorf("paginate yielded errors %v", errs)
+			}
+			if !slices.Equal(items, test.items) {
+				t.Errorf("paginate yielded %v, want %v", items, test.items)
+			}
+			if !slices.Equal(l.offsets, test.offsets) {
+				t.Errorf("paginate fetched offsets %v, want %v", l.offsets, test.offsets)
+			}
+		})
+	}
+}
+
+func TestPaginateError(t *testing.T) {
+	fail := errors.New("fetch failed")
+	l := &fakeList{total: 25, failAt: 10, err: fail}
+	items, errs := collect(paginate(context.Background(), 0, 10, l.fetch), 0)
+	if !slices.Equal(items, span(0, 10)) {
+		t.Errorf("paginate yielded %v before the error, want the first page", items)
+	}
+	if len(errs) != 1 || !errors.Is(errs[0], fail) {
+		t.Errorf("paginate yielded errors %v, want the fetch error once", errs)
+	}
+	if !slices.Equal(l.offsets, []int64{0, 10}) {
+		t.Errorf("paginate fetched offsets %v after the error, want none", l.offsets)
+	}
+}
+
+func TestPaginateCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	l := &fakeList{total: 25}
+	var (
+		items []int64
+		errs  []error
+	)
+	for item, err := range paginate(ctx, 0, 10, l.fetch) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		items = append(items, item)
+		if item == 4 {
+			cancel()
+		}
+	}
+	if !slices.Equal(items, span(0, 10)) {
+		t.Errorf("paginate yielded %v, want the page fetched before the cancellation", items)
+	}
+	if len(errs) != 1 || !errors.Is(errs[0], context.Canceled) {
+		t.Errorf("paginate yielded errors %v, want context.Canceled once", errs)
+	}
+	if !slices.Equal(l.offsets, []int64{0}) {
+		t.Errorf("paginate fetched offsets %v, want only the first page", l.offsets)
+	}
+}
+
+// cursorFetch serves l as pages that report whether a next page exists and the total count.
+func (l *fakeList) cursorFetch(ctx context.Context, offset, n int64) (cursorPage[int64], error) {
+	page, err := l.fetch(ctx, offset, n)
+	if err != nil {
+		return cursorPage[int64]{}, err
+	}
+	return cursorPage[int64]{results: page, hasNext: offset+n < l.total, totalCount: l.total}, nil
+}
+
+func TestCursor(t *testing.T) {
+	l := &fakeList{total: 25}
+	c, err := newCursor(context.Background(), 0, 10, l.cursorFetch)
+	if err != nil {
+		t.Fatalf("newCursor: %v", err)
+	}
+	if c.TotalCount() != 25 || !slices.Equal(l.offsets, []int64{0}) {
+		t.Errorf("before iterating TotalCount = %d after fetching offsets %v, want 25 from the first page", c.TotalCount(), l.offsets)
+	}
+
+	items, errs := collect(c.All(), 13)
+	if len(errs) > 0 || !slices.Equal(items, span(0, 13)) {
+		t.Errorf("All yielded %v and errors %v, want the first 13 items", items, errs)
+	}
+	if c.Offset() != 13 {
+		t.Fatalf("Offset after 13 items = %d, want 13", c.Offset())
+	}
+
+	// A new Cursor resumes from the saved offset
+	l.offsets = nil
+	resumed, err := newCursor(context.Background(), c.Offset(), 10, l.cursorFetch)
+	if err != nil {
+		t.Fatalf("newCursor: %v", err)
+	}
+	items, errs = collect(resumed.All(), 0)
+	if len(errs) > 0 || !slices.Equal(items, span(13, 25)) {
+		t.Errorf("resumed All yielded %v and errors %v, want the remaining items", items, errs)
+	}
+	if !slices.Equal(l.offsets, []int64{13, 23}) || resumed.Offset() != 25 || resumed.TotalCount() != 25 {
+		t.Errorf("resumed cursor fetched offsets %v and ended at %d of %d, want 13 and 23, 25 of 25",
+			l.offsets, resumed.Offset(), resumed.TotalCount())
+	}
+}
+
+func TestCursorError(t *testing.T) {
+	fail := errors.New("fetch failed")
+	if _, err := newCursor(context.Background(), 0, 10, (&fakeList{total: 25, err: fail}).cursorFetch); !errors.Is(err, fail) {
+		t.Errorf("newCursor with a failing first page = %v, want the fetch error", err)
+	}
+
+	l := &fakeList{total: 25, failAt: 10, err: fail}
+	c, err := newCursor(context.Background(), 0, 10, l.cursorFetch)
+	if err != nil {
+		t.Fatalf("newCursor: %v", err)
+	}
+	items, errs := collect(c.All(), 0)
+	if !slices.Equal(items, span(0, 10)) || len(errs) != 1 || !errors.Is(errs[0], fail) {
+		t.Errorf("All yielded %v and errors %v, want the first page and the fetch error once", items, errs)
+	}
+
+	// Ranging again retries the failed page
+	l.err = nil
+	items, errs = collect(c.All(), 0)
+	if len(errs) > 0 || !slices.Equal(items, span(10, 25)) {
+		t.Errorf("All after the error yielded %v and errors %v, want the remaining items", items, errs)
+	}
+}
@@ -0,0 +1,731 @@
+package vrchat
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/coder/websocket"
+)
+
+// DefaultPipelineURL is the URL of the VRChat pipeline, the WebSocket pushing realtime
+// events to a logged in user.
+const DefaultPipelineURL = "wss://pipeline.vrchat.cloud/"
+
+// Pipeline messages larger than this are rejected, user-update events carry the whole user.
+const maxPipelineMessage = 1 << 20
+
+// Defaults of the heartbeats of a Pipeline, see PipelineOptions.
+const (
+	DefaultHeartbeatInterval = 30 * time.Second
+	DefaultHeartbeatTimeout  = 10 * time.Second
+)
+
+// Errors reported by a Pipeline.
+var (
+	// ErrNotLoggedIn is returned by ConnectPipeline when the Client holds no session.
+	ErrNotLoggedIn = errors.New("vrchat: not logged in")
+	// ErrPipelineStale is reported by a DisconnectedEvent when the connection stopped
+	// answering heartbeats.
+	ErrPipelineStale = errors.New("vrchat: pipeline connection stale")
+)
+
+// Types of the events sent by the pipeline.
+const (
+	EventNotification         = "notification"
+	EventResponseNotification = "response-notification"
+	EventSeeNotification      = "see-notification"
+	EventHideNotification     = "hide-notification"
+	EventClearNotification    = "clear-notification"
+	EventFriendAdd            = "friend-add"
+	EventFriendDelete         = "friend-delete"
+	EventFriendOnline         = "friend-online"
+	EventFriendActive         = "friend-active"
+	EventFriendOffline        = "friend-offline"
+	EventFriendUpdate         = "friend-update"
+	EventFriendLocation       = "friend-location"
+	EventUserUpdate           = "user-update"
+	EventUserLocation         = "user-location"
+	EventUserBadgeAssigned    = "user-badge-assigned"
+	EventUserBadgeUnassigned  = "user-badge-unassigned"
+	EventContentRefresh       = "content-refresh"
+	EventInstanceQueueJoined  = "instance-queue-joined"
+	EventInstanceQueueReady   = "instance-queue-ready"
+	EventGroupJoined          = "group-joined"
+	EventGroupLeft            = "group-left"
+	EventGroupMemberUpdated   = "group-member-updated"
+	EventGroupRoleUpdated     = "group-role-updated"
+
+	// The lifecycle events are not sent by the pipeline but by Pipeline itself.
+	EventConnected    = "connected"
+	EventDisconnected = "disconnected"
+	EventResynced     = "resynced"
+)
+
+// Event is an event received from the pipeline. It is one of the *Event types of this
+// package, events of a type the package does not know are delivered as *UnknownEvent.
+type Event interface {
+	// EventType returns the type of the pipeline message, e.g. EventFriendOnline.
+	EventType() string
+}
+
+// NotificationEvent delivers a new notification.
+type NotificationEvent struct {
+	Notification Notification
+}
+
+// ResponseNotificationEvent reports a response to a notification the user sent.
+type ResponseNotificationEvent struct {
+	NotificationId string `json:"notificationId"`
+	ReceiverId     UserId `json:"receiverId"`
+	ResponseId     string `json:"responseId"`
+}
+
+// SeeNotificationEvent reports that a notification was marked as seen.
+type SeeNotificationEvent struct {
+	NotificationId string
+}
+
+// HideNotificationEvent reports that a notification was hidden.
+type HideNotificationEvent struct {
+	NotificationId string
+}
+
+// ClearNotificationEvent reports that all notifications were cleared.
+type ClearNotificationEvent struct{}
+
+// FriendAddEvent reports a new friend.
+type FriendAddEvent struct {
+	UserId UserId            `json:"userId"`
+	User   LimitedUserFriend `json:"user"`
+}
+
+// FriendDeleteEvent reports that a user is no longer a friend.
+type FriendDeleteEvent struct {
+	UserId UserId `json:"userId"`
+}
+
+// FriendOnlineEvent reports that a friend came online in the game.
+type FriendOnlineEvent struct {
+	UserId           UserId            `json:"userId"`
+	Platform         string            `json:"platform"`
+	Location         string            `json:"location"`
+	CanRequestInvite bool              `json:"canRequestInvite"`
+	User             LimitedUserFriend `json:"user"`
+}
+
+// FriendActiveEvent reports that a friend is active on the website.
+type FriendActiveEvent struct {
+	UserId   UserId            `json:"userid"`
+	Platform string            `json:"platform"`
+	User     LimitedUserFriend `json:"user"`
+}
+
+// FriendOfflineEvent reports that a friend went offline.
+type FriendOfflineEvent struct {
+	UserId   UserId `json:"userId"`
+	Platform string `json:"platform"`
+}
+
+// FriendUpdateEvent reports a change to the profile of a friend.
+type FriendUpdateEvent struct {
+	UserId UserId            `json:"userId"`
+	User   LimitedUserFriend `json:"user"`
+}
+
+// FriendLocationEvent reports that a friend changed instances.
+type FriendLocationEvent struct {
+	UserId              UserId            `json:"userId"`
+	Location            string            `json:"location"`
+	TravelingToLocation string            `json:"travelingToLocation"`
+	WorldId             WorldId           `json:"worldId"`
+	CanRequestInvite    bool              `json:"canRequestInvite"`
+	User                LimitedUserFriend `json:"user"`
+}
+
+// UserUpdateEvent reports a change to the current user. User only holds the profile
+// fields sent by the pipeline, fetch the current user for the rest.
+type UserUpdateEvent struct {
+	UserId UserId      `json:"userId"`
+	User   CurrentUser `json:"user"`
+}
+
+// UserLocationEvent reports that the current user changed instances.
+type UserLocationEvent struct {
+	UserId              UserId `json:"userId"`
+	Location            string `json:"location"`
+	Instance            string `json:"instance"`
+	TravelingToLocation string `json:"travelingToLocation"`
+}
+
+// UserBadgeAssignedEvent reports a badge given to the current user.
+type UserBadgeAssignedEvent struct {
+	Badge Badge `json:"badge"`
+}
+
+// UserBadgeUnassignedEvent reports a badge taken from the current user.
+type UserBadgeUnassignedEvent struct {
+	BadgeId BadgeId `json:"badgeId"`
+}
+
+// ContentRefreshEvent reports that content of the current user, such as an avatar,
+// gallery image or inventory item, was added, changed or removed.
+type ContentRefreshEvent struct {
+	ContentType string `json:"contentType"`
+	FileId      FileId `json:"fileId"`
+	ItemId      string `json:"itemId"`
+	ItemType    string `json:"itemType"`
+	ActionType  string `json:"actionType"`
+}
+
+// InstanceQueueJoinedEvent reports the position of the current user in the queue of a full instance.
+type InstanceQueueJoinedEvent struct {
+	InstanceLocation string `json:"instanceLocation"`
+	Position         int64  `json:"position"`
+}
+
+// InstanceQueueReadyEvent reports that the current user can join the instance it queued for,
+// before Expiry.
+type InstanceQueueReadyEvent struct {
+	InstanceLocation string `json:"instanceLocation"`
+	Expiry           int64  `json:"expiry"`
+}
+
+// GroupJoinedEvent reports that the current user joined a group.
+type GroupJoinedEvent struct {
+	GroupId GroupId `json:"groupId"`
+}
+
+// GroupLeftEvent reports that the current user left a group.
+type GroupLeftEvent struct {
+	GroupId GroupId `json:"groupId"`
+}
+
+// GroupMemberUpdatedEvent reports a change to the membership of the current user in a group.
+type GroupMemberUpdatedEvent struct {
+	Member GroupLimitedMember `json:"member"`
+}
+
+// GroupRoleUpdatedEvent reports a change to a role of a group the current user is in.
+type GroupRoleUpdatedEvent struct {
+	Role GroupRole `json:"role"`
+}
+
+// ConnectedEvent reports that the pipeline connected, it is the first event of a Pipeline
+// and follows every DisconnectedEvent it recovers from.
+type ConnectedEvent struct {
+	// Reconnected is true if the connection replaces one that was lost.
+	Reconnected bool
+}
+
+// DisconnectedEvent reports that the connection was lost, events sent until the next
+// ConnectedEvent are missed.
+type DisconnectedEvent struct {
+	// Err is the reason the connection was lost, ErrPipelineStale if it stopped answering heartbeats.
+	Err error
+}
+
+// ResyncedEvent follows a reconnect once the state missed while disconnected should be
+// fetched again. It carries the current user, including the friends currently online.
+//
+// When the first attempt to reconnect succeeded it comes right away. Otherwise it is
+// delayed by a random time up to the websocketMaxFriendsRefreshDelay of the API config,
+// as VRChat asks clients to spread the load of refreshing after an outage.
+type ResyncedEvent struct {
+	// Downtime is the time the pipeline was disconnected.
+	Downtime time.Duration
+	// User is the current user, nil if fetching it failed with Err.
+	User *CurrentUser
+	Err  error
+}
+
+// UnknownEvent holds a message of a type this package does not know, or whose content
+// could not be decoded into its event.
+type UnknownEvent struct {
+	Type    string
+	Content json.RawMessage
+	// Err is the error decoding the content of a known type.
+	Err error
+}
+
+func (*NotificationEvent) EventType() string         { return EventNotification }
+func (*ResponseNotificationEvent) EventType() string { return EventResponseNotification }
+func (*SeeNotificationEvent) EventType() string      { return EventSeeNotification }
+func (*HideNotificationEvent) EventType() string     { return EventHideNotification }
+func (*ClearNotificationEvent) EventType() string    { return EventClearNotification }
+func (*FriendAddEvent) EventType() string            { return EventFriendAdd }
+func (*FriendDeleteEvent) EventType() string         { return EventFriendDelete }
+func (*FriendOnlineEvent) EventType() string         { return EventFriendOnline }
+func (*FriendActiveEvent) EventType() string         { return EventFriendActive }
+func (*FriendOfflineEvent) EventType() string        { return EventFriendOffline }
+func (*FriendUpdateEvent) EventType() string         { return EventFriendUpdate }
+func (*FriendLocationEvent) EventType() string       { return EventFriendLocation }
+func (*UserUpdateEvent) EventType() string           { return EventUserUpdate }
+func (*UserLocationEvent) EventType() string         { return EventUserLocation }
+func (*UserBadgeAssignedEvent) EventType() string    { return EventUserBadgeAssigned }
+func (*UserBadgeUnassignedEvent) EventType() string  { return EventUserBadgeUnassigned }
+func (*ContentRefreshEvent) EventType() string       { return EventContentRefresh }
+func (*InstanceQueueJoinedEvent) EventType() string  { return EventInstanceQueueJoined }
+func (*InstanceQueueReadyEvent) EventType() string   { return EventInstanceQueueReady }
+func (*GroupJoinedEvent) EventType() string          { return EventGroupJoined }
+func (*GroupLeftEvent) EventType() string            { return EventGroupLeft }
+func (*GroupMemberUpdatedEvent) EventType() string   { return EventGroupMemberUpdated }
+func (*GroupRoleUpdatedEvent) EventType() string     { return EventGroupRoleUpdated }
+func (*ConnectedEvent) EventType() string            { return EventConnected }
+func (*DisconnectedEvent) EventType() string         { return EventDisconnected }
+func (*ResyncedEvent) EventType() string             { return EventResynced }
+func (e *UnknownEvent) EventType() string            { return e.Type }
+
+// pipelineEvents creates the event for each known message type.
+var pipelineEvents = map[string]func() Event{
+	EventResponseNotification: func() Event { return &ResponseNotificationEvent{} },
+	EventFriendAdd:            func() Event { return &FriendAddEvent{} },
+	EventFriendDelete:         func() Event { return &FriendDeleteEvent{} },
+	EventFriendOnline:         func() Event { return &FriendOnlineEvent{} },
+	EventFriendActive:         func() Event { return &FriendActiveEvent{} },
+	EventFriendOffline:        func() Event { return &FriendOfflineEvent{} },
+	EventFriendUpdate:         func() Event { return &FriendUpdateEvent{} },
+	EventFriendLocation:       func() Event { return &FriendLocationEvent{} },
+	EventUserUpdate:           func() Event { return &UserUpdateEvent{} },
+	EventUserLocation:         func() Event { return &UserLocationEvent{} },
+	EventUserBadgeAssigned:    func() Event { return &UserBadgeAssignedEvent{} },
+	EventUserBadgeUnassigned:  func() Event { return &UserBadgeUnassignedEvent{} },
+	EventContentRefresh:       func() Event { return &ContentRefreshEvent{} },
+	EventInstanceQueueJoined:  func() Event { return &InstanceQueueJoinedEvent{} },
+	EventInstanceQueueReady:   func() Event { return &InstanceQueueReadyEvent{} },
+	EventGroupJoined:          func() Event { return &GroupJoinedEvent{} },
+	EventGroupLeft:            func() Event { return &GroupLeftEvent{} },
+	EventGroupMemberUpdated:   func() Event { return &GroupMemberUpdatedEvent{} },
+	EventGroupRoleUpdated:     func() Event { return &GroupRoleUpdatedEvent{} },
+}
+
+// pipelineMessage is a message of the pipeline. Content is usually a string holding
+// JSON, which is decoded a second time.
+type pipelineMessage struct {
+	Type    string          `json:"type"`
+	Content json.RawMessage `json:"content"`
+}
+
+// decodeEvent decodes a pipeline message into its event, it only fails if the message
+// itself is not valid.
+func decodeEvent(data []byte) (Event, error) {
+	var msg pipelineMessage
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return nil, err
+	}
+	content := []byte(msg.Content)
+	var encoded string
+	if json.Unmarshal(msg.Content, &encoded) == nil {
+		content = []byte(encoded)
+	}
+
+	switch msg.Type {
+	case EventSeeNotification:
+		return &SeeNotificationEvent{NotificationId: encoded}, nil
+	case EventHideNotification:
+		return &HideNotificationEvent{NotificationId: encoded}, nil
+	case EventClearNotification:
+		return &ClearNotificationEvent{}, nil
+	case EventNotification:
+		var notification Notification
+		if err := json.Unmarshal(content, &notification); err != nil {
+			return &UnknownEvent{Type: msg.Type, Content: content, Err: err}, nil
+		}
+		return &NotificationEvent{Notification: notification}, nil
+	}
+
+	newEvent, ok := pipelineEvents[msg.Type]
+	if !ok {
+		return &UnknownEvent{Type: msg.Type, Content: content}, nil
+	}
+	event := newEvent()
+	if err := json.Unmarshal(content, event); err != nil {
+		return &UnknownEvent{Type: msg.Type, Content: content, Err: err}, nil
+	}
+	return event, nil
+}
+
+// PipelineOptions configures ConnectPipeline.
+type PipelineOptions struct {
+	// URL is the URL of the pipeline, DefaultPipelineURL when empty.
+	URL string
+	// Buffer is the capacity of the channel returned by Pipeline.Events. While it is full
+	// the connection is not read, so a slow consumer holds back the pipeline rather than
+	// losing events. Heartbeats are suspended meanwhile.
+	Buffer int
+	// DisableReconnect stops the pipeline when its connection is lost instead of
+	// connecting again.
+	DisableReconnect bool
+	// HeartbeatInterval is the time between two pings checking that the connection is
+	// alive, DefaultHeartbeatInterval when zero. A negative interval disables heartbeats.
+	HeartbeatInterval time.Duration
+	// HeartbeatTimeout is how long a ping may go unanswered before the connection is
+	// considered stale and replaced, DefaultHeartbeatTimeout when zero.
+	HeartbeatTimeout time.Duration
+}
+
+// Pipeline is a connection to the pipeline, delivering realtime events such as friends
+// coming online or changing instances and new notifications. Close it when done.
+//
+// A lost or stale connection is replaced automatically, waiting between attempts as
+// asked by the websocket settings of the API config. The lifecycle of the connection
+// is reported by ConnectedEvent, DisconnectedEvent and ResyncedEvent.
+type Pipeline struct {
+	client *Client
+	url    string
+	opts   PipelineOptions
+	events chan Event
+	cancel context.CancelFunc
+	// reconnect holds the reconnect delays, fetched from the API config on the first reconnect
+	reconnect *reconnectDelays
+
+	mu     sync.Mutex
+	conn   *websocket.Conn
+	err    error
+	closed bool
+}
+
+// ConnectPipeline connects to the pipeline with the session of c, which must be logged in.
+// The connection lasts until it is closed or ctx is done.
+func (c *Client) ConnectPipeline(ctx context.Context, opts PipelineOptions) (*Pipeline, error) {
+	if opts.URL == "" {
+		opts.URL = DefaultPipelineURL
+	}
+	if opts.HeartbeatInterval == 0 {
+		opts.HeartbeatInterval = DefaultHeartbeatInterval
+	}
+	if opts.HeartbeatTimeout == 0 {
+		opts.HeartbeatTimeout = DefaultHeartbeatTimeout
+	}
+
+	conn, err := c.dialPipeline(ctx, opts.URL)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	p := &Pipeline{
+		client: c,
+		url:    opts.URL,
+		opts:   opts,
+		events: make(chan Event, opts.Buffer),
+		cancel: cancel,
+		conn:   conn,
+	}
+	go p.run(ctx, conn)
+	return p, nil
+}
+
+// dialPipeline opens a connection to the pipeline at pipelineURL with the current session of c.
+func (c *Client) dialPipeline(ctx context.Context, pipelineURL string) (*websocket.Conn, error) {
+	session := c.Session()
+	if session == nil {
+		return nil, ErrNotLoggedIn
+	}
+	u, err := url.Parse(pipelineURL)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing pipeline URL: %w", err)
+	}
+	query := u.Query()
+	query.Set("authToken", session.Auth)
+	u.RawQuery = query.Encode()
+
+	header := make(http.Header)
+	header.Set("User-Agent", c.client.Header.Get("User-Agent"))
+	conn, resp, err := websocket.Dial(ctx, u.String(), &websocket.DialOptions{
+		HTTPClient: c.client.GetClient(),
+		HTTPHeader: header,
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusUnauthorized {
+			return nil, fmt.Errorf("error connecting to pipeline: %w", ErrUnauthorized)
+		}
+		return nil, fmt.Errorf("error connecting to pipeline: %w", hideAuthToken(err, session.Auth))
+	}
+	conn.SetReadLimit(maxPipelineMessage)
+	return conn, nil
+}
+
+// hideAuthToken removes the session token from the URL a failed dial reports, the
+// pipeline takes it in the query.
+func hideAuthToken(err error, token string) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		u, parseErr := url.Parse(urlErr.URL)
+		if parseErr != nil {
+			return errors.New(strings.ReplaceAll(err.Error(), token, redacted))
+		}
+		u.RawQuery = ""
+		return &url.Error{Op: urlErr.Op, URL: u.String(), Err: urlErr.Err}
+	}
+	if strings.Contains(err.Error(), token) {
+		return errors.New(strings.ReplaceAll(err.Error(), token, redacted))
+	}
+	return err
+}
+
+// Events returns the channel the events are delivered on. It is closed when the
+// pipeline stops, after which Err returns the reason.
+func (p *Pipeline) Events() <-chan Event {
+	return p.events
+}
+
+// Err returns the error that stopped the pipeline, nil while it runs or if it was closed
+// by Close or its context.
+func (p *Pipeline) Err() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.err
+}
+
+// Close closes the connection, the channel returned by Events is closed shortly after.
+func (p *Pipeline) Close() error {
+	p.mu.Lock()
+	p.closed = true
+	conn := p.conn
+	p.mu.Unlock()
+	defer p.cancel()
+	if conn == nil {
+		return nil
+	}
+	return conn.Close(websocket.StatusNormalClosure, "")
+}
+
+// run delivers the events of conn and of the connections replacing it until the
+// pipeline is closed, ctx is done or it cannot connect again.
+func (p *Pipeline) run(ctx context.Context, conn *websocket.Conn) {
+	defer close(p.events)
+	if !p.emit(ctx, &ConnectedEvent{}) {
+		conn.CloseNow()
+		return
+	}
+	var (
+		resync   bool
+		quick    bool
+		downtime time.Duration
+	)
+	for {
+		err := p.serve(ctx, conn, resync, quick, downtime)
+		if p.isClosed() || ctx.Err() != nil {
+			return
+		}
+		if p.opts.DisableReconnect {
+			p.stop(err)
+			p.emit(ctx, &DisconnectedEvent{Err: err})
+			return
+		}
+		if !p.emit(ctx, &DisconnectedEvent{Err: err}) {
+			return
+		}
+
+		lost := time.Now()
+		var attempts int
+		conn, attempts, err = p.redial(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				p.stop(err)
+			}
+			return
+		}
+		if !p.emit(ctx, &ConnectedEvent{Reconnected: true}) {
+			conn.CloseNow()
+			return
+		}
+		resync, quick, downtime = true, attempts == 1, time.Since(lost)
+	}
+}
+
+// serve delivers the events of conn until it fails, keeping it alive with heartbeats.
+// After a reconnect it also resyncs the state that changed during the downtime, quick
+// is true if the first attempt to reconnect succeeded.
+func (p *Pipeline) serve(ctx context.Context, conn *websocket.Conn, resync, quick bool, downtime time.Duration) error {
+	connCtx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	defer cancel()
+	defer conn.CloseNow()
+
+	var (
+		stale  error
+		stalls stalls
+	)
+	if p.opts.HeartbeatInterval > 0 {
+		wg.Go(func() {
+			if err := p.heartbeat(connCtx, conn, &stalls); err != nil {
+				stale = err
+				conn.CloseNow()
+			}
+		})
+	}
+	if resync {
+		wg.Go(func() {
+			p.resync(connCtx, quick, downtime)
+		})
+	}
+
+	for {
+		_, data, err := conn.Read(connCtx)
+		if err != nil {
+			cancel()
+			wg.Wait()
+			if stale != nil {
+				return stale
+			}
+			return fmt.Errorf("error reading from pipeline: %w", err)
+		}
+		// Keep-alive messages of the pipeline carry nothing
+		if len(bytes.TrimSpace(data)) == 0 {
+			continue
+		}
+		event, err := decodeEvent(data)
+		if err != nil {
+			return fmt.Errorf("error decoding pipeline message: %w", err)
+		}
+		if !p.deliver(connCtx, event, &stalls) {
+			return ctx.Err()
+		}
+	}
+}
+
+// stalls tracks the reader of a connection waiting for the consumer of the events.
+// Pongs are only read while the reader reads, so pings are not answered meanwhile.
+type stalls struct {
+	blocked atomic.Bool
+	count   atomic.Uint64
+}
+
+// deliver is like emit for an event read from a connection, recording in stalls when
+// the consumer does not keep up.
+func (p *Pipeline) deliver(ctx context.Context, event Event, stalls *stalls) bool {
+	select {
+	case p.events <- event:
+		return true
+	default:
+	}
+	stalls.count.Add(1)
+	stalls.blocked.Store(true)
+	defer stalls.blocked.Store(false)
+	return p.emit(ctx, event)
+}
+
+// heartbeat pings conn until ctx is done, it returns an error when a ping is not answered
+// in time. Pings are skipped while the reader waits for the consumer, and a ping that was
+// not answered because it did is not held against the connection.
+func (p *Pipeline) heartbeat(ctx context.Context, conn *websocket.Conn, stalls *stalls) error {
+	ticker := time.NewTicker(p.opts.HeartbeatInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		if stalls.blocked.Load() {
+			continue
+		}
+		before := stalls.count.Load()
+		pingCtx, cancel := context.WithTimeout(ctx, p.opts.HeartbeatTimeout)
+		err := conn.Ping(pingCtx)
+		cancel()
+		if err != nil && ctx.Err() == nil {
+			if stalls.blocked.Load() || stalls.count.Load() != before {
+				continue
+			}
+			return fmt.Errorf("%w: ping not answered: %w", ErrPipelineStale, err)
+		}
+	}
+}
+
+// resync waits for the refresh delay unless the reconnect was quick, then fetches the
+// current user and reports it with a ResyncedEvent.
+func (p *Pipeline) resync(ctx context.Context, quick bool, downtime time.Duration) {
+	if delay := p.reconnect.refreshDelay(quick); delay > 0 {
+		if sleep(ctx, delay) != nil {
+			return
+		}
+	}
+	event := &ResyncedEvent{Downtime: downtime}
+	user, err := p.client.GetCurrentUserWithContext(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		event.Err = err
+	} else {
+		event.User = (*CurrentUser)(user)
+	}
+	p.emit(ctx, event)
+}
+
+// redial connects to the pipeline again, waiting between attempts, and returns the
+// number of attempts it took. A session the pipeline rejects is renewed if the client
+// can log in by itself.
+func (p *Pipeline) redial(ctx context.Context) (*websocket.Conn, int, error) {
+	if p.reconnect == nil {
+		p.reconnect = p.client.reconnectDelays(ctx)
+	}
+	reauthenticated := false
+	for attempt := 0; ; attempt++ {
+		if err := sleep(ctx, p.reconnect.backoff(attempt)); err != nil {
+			return nil, 0, err
+		}
+
+		generation := p.client.authGeneration.Load()
+		conn, err := p.client.dialPipeline(ctx, p.url)
+		if errors.Is(err, ErrUnauthorized) || errors.Is(err, ErrNotLoggedIn) {
+			if reauthenticated || !p.client.canReauthenticate(ctx) {
+				return nil, 0, err
+			}
+			if err := p.client.reauthenticate(ctx, generation); err != nil {
+				return nil, 0, err
+			}
+			reauthenticated = true
+			attempt = -1
+			continue
+		}
+		if err != nil {
+			continue
+		}
+
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		if p.closed {
+			conn.CloseNow()
+			return nil, 0, context.Canceled
+		}
+		p.conn = conn
+		return conn, attempt + 1, nil
+	}
+}
+
+// emit delivers event unless ctx is done first.
+func (p *Pipeline) emit(ctx context.Context, event Event) bool {
+	select {
+	case p.events <- event:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func (p *Pipeline) isClosed() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.closed
+}
+
+// stop records the error that stopped the pipeline unless it was closed.
+func (p *Pipeline) stop(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.closed {
+		p.err = err
+	}
+}
@@ -0,0 +1,238 @@
+package vrchat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coder/websocket"
+)
+
+const (
+	testAuthToken = "authcookie_c1644b5b-3ca4-45b4-97c6-a2a0de70d469"
+	testWorld     = "wrld_4432ea9b-729c-46e3-8eaf-846aa0a37fdd"
+	// testNotification is a notification as the pipeline sends it, with its details as an object
+	testNotification = `{"id":"not_9a4a8b4e-9b2c-4b9e-8d2e-3f9c0b8c6d1a","type":"invite",` +
+		`"senderUserId":"usr_c1644b5b-3ca4-45b4-97c6-a2a0de70d469","message":"",` +
+		`"details":{"worldId":"` + testWorld + `:12345","worldName":"Test World"},` +
+		`"created_at":"2026-01-02T03:04:05.000Z"}`
+)
+
+// encodePipelineMessage returns a pipeline message whose content is the JSON text content encoded as a string.
+func encodePipelineMessage(t *testing.T, eventType, content string) []byte {
+	t.Helper()
+	encoded, err := json.Marshal(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(pipelineMessage{Type: eventType, Content: encoded})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestDecodeEvent(t *testing.T) {
+	event, err := decodeEvent(encodePipelineMessage(t, EventNotification, testNotification))
+	if err != nil {
+		t.Fatalf("decodeEvent: %v", err)
+	}
+	notification, ok := event.(*NotificationEvent)
+	if !ok {
+		t.Fatalf("decodeEvent = %#v, want a *NotificationEvent", event)
+	}
+	if notification.Notification.Type != NotificationTypeInvite || notification.Notification.SenderUserId != "usr_c1644b5b-3ca4-45b4-97c6-a2a0de70d469" {
+		t.Errorf("decodeEvent = %+v", notification.Notification)
+	}
+	detail, err := notification.Notification.Detail()
+	if err != nil {
+		t.Fatalf("Detail: %v", err)
+	}
+	if invite, ok := detail.(NotificationDetailInvite); !ok || invite.WorldId != testWorld+":12345" || invite.WorldName != "Test World" {
+		t.Errorf("Detail = %#v", detail)
+	}
+
+	online := `{"userId":"usr_c1644b5b-3ca4-45b4-97c6-a2a0de70d469","platform":"standalonewindows",` +
+		`"location":"traveling","canRequestInvite":true,"user":{"id":"usr_c1644b5b-3ca4-45b4-97c6-a2a0de70d469"}}`
+	event, err = decodeEvent(encodePipelineMessage(t, EventFriendOnline, online))
+	if err != nil {
+		t.Fatalf("decodeEvent: %v", err)
+	}
+	if friend, ok := event.(*FriendOnlineEvent); !ok || friend.UserId != "usr_c1644b5b-3ca4-45b4-97c6-a2a0de70d469" ||
+		friend.Location != LocationTraveling || !friend.CanRequestInvite {
+		t.Errorf("decodeEvent = %#v, want the friend-online event", event)
+	}
+
+	event, err = decodeEvent([]byte(`{"type":"see-notification","content":"not_9a4a8b4e-9b2c-4b9e-8d2e-3f9c0b8c6d1a"}`))
+	if err != nil {
+		t.Fatalf("decodeEvent: %v", err)
+	}
+	if seen, ok := event.(*SeeNotificationEvent); !ok || seen.NotificationId != "not_9a4a8b4e-9b2c-4b9e-8d2e-3f9c0b8c6d1a" {
+		t.Errorf("decodeEvent = %#v, want the see-notification event", event)
+	}
+
+	event, err = decodeEvent(encodePipelineMessage(t, "new-event", `{"a":1}`))
+	if err != nil {
+		t.Fatalf("decodeEvent: %v", err)
+	}
+	if unknown, ok := event.(*UnknownEvent); !ok || unknown.EventType() != "new-event" ||
+		string(unknown.Content) != `{"a":1}` || unknown.Err != nil {
+		t.Errorf("decodeEvent = %#v, want an unknown event", event)
+	}
+
+	event, err = decodeEvent(encodePipelineMessage(t, EventFriendOnline, `{"userId":1}`))
+	if err != nil {
+		t.Fatalf("decodeEvent: %v", err)
+	}
+	if unknown, ok := event.(*UnknownEvent); !ok || unknown.EventType() != EventFriendOnline || unknown.Err == nil {
+		t.Errorf("decodeEvent = %#v, want an unknown event with the decoding error", event)
+	}
+
+	if _, err := decodeEvent([]byte(`not json`)); err == nil {
+		t.Error("decodeEvent of a malformed message succeeded")
+	}
+}
+
+// newPipelineServer returns a server accepting pipeline connections with testAuthToken,
+// sending them messages and closing them.
+func newPipelineServer(t *testing.T, messages ...[]byte) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("authToken") != testAuthToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		conn, err := websocket.Accept(w, r, nil)
+		if err != nil {
+			t.Errorf("Accept: %v", err)
+			return
+		}
+		defer conn.CloseNow()
+		ctx := r.Context()
+		for _, message := range messages {
+			if err := conn.Write(ctx, websocket.MessageText, message); err != nil {
+				t.Errorf("Write: %v", err)
+				return
+			}
+		}
+		conn.Close(websocket.StatusGoingAway, "bye")
+	}))
+}
+
+func TestPipeline(t *testing.T) {
+	server := newPipelineServer(t,
+		encodePipelineMessage(t, EventNotification, testNotification),
+		[]byte(" "),
+		encodePipelineMessage(t, "new-event", `{"a":1}`),
+	)
+	defer server.Close()
+
+	c := NewClient(server.URL, "test", WithRateLimiter(nil))
+	c.SetSession(&Session{Auth: testAuthToken})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	p, err := c.ConnectPipeline(ctx, PipelineOptions{
+		URL:               "ws" + strings.TrimPrefix(server.URL, "http"),
+		DisableReconnect:  true,
+		HeartbeatInterval: -1,
+	})
+	if err != nil {
+		t.Fatalf("ConnectPipeline: %v", err)
+	}
+	defer p.Close()
+
+	var events []Event
+	for event := range p.Events() {
+		events = append(events, event)
+	}
+	want := []string{EventConnected, EventNotification, "new-event", EventDisconnected}
+	if len(events) != len(want) {
+		t.Fatalf("got %d events %v, want %v", len(events), events, want)
+	}
+	for i, event := range events {
+		if event.EventType() != want[i] {
+			t.Errorf("event %d is %s, want %s", i, event.EventType(), want[i])
+		}
+	}
+	if connected, ok := events[0].(*ConnectedEvent); !ok || connected.Reconnected {
+		t.Errorf("first event = %#v, want the first connection", events[0])
+	}
+	if notification, ok := events[1].(*NotificationEvent); !ok || notification.Notification.Id != "not_9a4a8b4e-9b2c-4b9e-8d2e-3f9c0b8c6d1a" {
+		t.Errorf("second event = %#v, want the notification", events[1])
+	}
+	disconnected, ok := events[3].(*DisconnectedEvent)
+	if !ok || websocket.CloseStatus(disconnected.Err) != websocket.StatusGoingAway {
+		t.Errorf("last event = %#v, want the disconnection by the server", events[3])
+	}
+	if !errors.Is(p.Err(), disconnected.Err) {
+		t.Errorf("Err = %v, want %v", p.Err(), disconnected.Err)
+	}
+}
+
+func TestConnectPipelineUnauthorized(t *testing.T) {
+	server := newPipelineServer(t)
+	defer server.Close()
+	opts := PipelineOptions{URL: "ws" + strings.TrimPrefix(server.URL, "http")}
+
+	c := NewClient(server.URL, "test", WithRateLimiter(nil))
+	if _, err := c.ConnectPipeline(context.Background(), opts); !errors.Is(err, ErrNotLoggedIn) {
+		t.Errorf("ConnectPipeline without a session = %v, want ErrNotLoggedIn", err)
+	}
+
+	const expired = "authcookie_expired"
+	c.SetSession(&Session{Auth: expired})
+	_, err := c.ConnectPipeline(context.Background(), opts)
+	if !errors.Is(err, ErrUnauthorized) {
+		t.Errorf("ConnectPipeline with an expired session = %v, want ErrUnauthorized", err)
+	}
+	if err != nil && strings.Contains(err.Error(), expired) {
+		t.Errorf("ConnectPipeline error %q holds the session token", err)
+	}
+}
+
+func TestPipelineRedialReauthenticates(t *testing.T) {
+	pipeline := newPipelineServer(t)
+	defer pipeline.Close()
+	var logins int
+	mux := http.NewServeMux()
+	mux.HandleFunc("/auth/user", func(w http.ResponseWriter, r *http.Request) {
+		logins++
+		http.SetCookie(w, &http.Cookie{Name: AuthCookie, Value: testAuthToken, Path: "/"})
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(testUser))
+	})
+	api := httptest.NewServer(mux)
+	defer api.Close()
+
+	c := NewClient(api.URL, "test",
+		WithRateLimiter(nil),
+		WithLogger(discardLogger{}),
+		WithCredentials(StaticCredentials(Credentials{Username: "bot", Password: "secret"})),
+	)
+	c.SetSession(&Session{Auth: "authcookie_expired"})
+	p := &Pipeline{
+		client:    c,
+		url:       "ws" + strings.TrimPrefix(pipeline.URL, "http"),
+		reconnect: &reconnectDelays{quick: time.Millisecond, max: time.Millisecond},
+	}
+	conn, attempts, err := p.redial(context.Background())
+	if err != nil {
+		t.Fatalf("redial: %v", err)
+	}
+	conn.CloseNow()
+	if logins != 1 || attempts != 1 {
+		t.Errorf("redial logged in %d times and took %d attempts, want 1 and 1", logins, attempts)
+	}
+
+	// Without credentials the rejected session ends the pipeline
+	c = NewClient(api.URL, "test", WithRateLimiter(nil))
+	c.SetSession(&Session{Auth: "authcookie_expired"})
+	p.client = c
+	if _, _, err := p.redial(context.Background()); !errors.Is(err, ErrUnauthorized) {
+		t.Errorf("redial without credentials = %v, want ErrUnauthorized", err)
+	}
+}
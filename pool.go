@@ -0,0 +1,318 @@
+package vrchat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// A pool account with this many failures in a row is not picked by Pool.Do, until
+// poolCooldown passed since its last failure.
+const (
+	poolUnhealthyAfter = 3
+	poolCooldown       = time.Minute
+)
+
+// Errors returned by the routing methods of Pool.
+var (
+	ErrNoAccounts     = errors.New("vrchat: pool has no accounts")
+	ErrUnknownAccount = errors.New("vrchat: unknown pool account")
+)
+
+// PoolAccount describes an account managed by a Pool.
+type PoolAccount struct {
+	// Name identifies the account within the pool.
+	Name string
+	// Credentials are used to log in and to log in again when the session expired.
+	Credentials Credentials
+	// TOTPSecret lets the account complete TOTP two-factor authentication by itself.
+	TOTPSecret string
+	// Sessions persists the session of the account, it may be nil.
+	Sessions SessionStore
+	// RateLimiter paces the requests of the account, nil gives the account its own
+	// limiter allowing DefaultRate requests per second.
+	RateLimiter *RateLimiter
+	// CookieJar holds the session cookies of the account, nil gives the account its own
+	// in-memory jar. It must not be shared with another account.
+	CookieJar http.CookieJar
+}
+
+// AccountHealth is a snapshot of the state of a pool account.
+type AccountHealth struct {
+	Name string
+	// LoggedIn reports whether the account holds a session.
+	LoggedIn bool
+	// Healthy is false after several calls failed in a row with errors pointing at
+	// the account or the API rather than the call, such as 401, 429, 5xx or network errors.
+	// It turns true again a minute after the last failure, the next call then probes
+	// the account: a success makes it healthy for good, a failure sidelines it once more.
+	Healthy bool
+	// InFlight is the number of calls currently routed to the account.
+	InFlight int64
+	// Calls counts the calls routed to the account, Failures those that failed
+	// because of the account or the API, as opposed to e.g. a 404.
+	Calls    uint64
+	Failures uint64
+	// LastError is the error of the last failed call, at LastErrorAt.
+	LastError   error
+	LastErrorAt time.Time
+	// LastSuccessAt is the time the last call succeeded.
+	LastSuccessAt time.Time
+}
+
+// Pool manages the clients of several accounts and routes calls to them, either to
+// a chosen account or to the healthy account with the fewest calls in flight.
+// Every account has its own session and rate limit budget. A Pool is safe for
+// concurrent use.
+type Pool struct {
+	baseURL   string
+	userAgent string
+	opts      []Option
+
+	mu       sync.RWMutex
+	accounts []*poolAccount
+	byName   map[string]*poolAccount
+}
+
+type poolAccount struct {
+	name   string
+	creds  Credentials
+	client *Client
+
+	inFlight atomic.Int64
+
+	mu            sync.Mutex
+	calls         uint64
+	failures      uint64
+	failuresInRow int
+	lastErr       error
+	lastErrAt     time.Time
+	lastSuccessAt time.Time
+}
+
+// NewPool returns an empty Pool whose clients are created like NewClient(baseURL, userAgent, opts...).
+// A WithCookieJar option is overridden by PoolAccount.CookieJar, every account keeps its
+// session in a jar of its own.
+func NewPool(baseURL, userAgent string, opts ...Option) *Pool {
+	return &Pool{
+		baseURL:   baseURL,
+		userAgent: userAgent,
+		opts:      opts,
+		byName:    make(map[string]*poolAccount),
+	}
+}
+
+// Add adds account to the pool and returns its client, which is not logged in yet.
+// An account with the same name is replaced. An invalid TOTPSecret makes the logins of
+// the account fail, see WithTOTPSecret.
+func (p *Pool) Add(account PoolAccount) *Client {
+	limiter := account.RateLimiter
+	if limiter == nil {
+		limiter = NewRateLimiter(DefaultRate, DefaultBurst)
+	}
+	opts := append(p.opts[:len(p.opts):len(p.opts)],
+		WithRateLimiter(limiter),
+		WithCredentials(StaticCredentials(account.Credentials)),
+		WithTOTPSecret(account.TOTPSecret),
+		WithSessionStore(account.Sessions),
+		WithCookieJar(account.CookieJar),
+	)
+	a := &poolAccount{
+		name:   account.Name,
+		creds:  account.Credentials,
+		client: NewClient(p.baseURL, p.userAgent, opts...),
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if old, ok := p.byName[a.name]; ok {
+		for i, existing := range p.accounts {
+			if existing == old {
+				p.accounts[i] = a
+			}
+		}
+	} else {
+		p.accounts = append(p.accounts, a)
+	}
+	p.byName[a.name] = a
+	return a.client
+}
+
+// Remove removes the named account from the pool.
+func (p *Pool) Remove(name string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	a, ok := p.byName[name]
+	if !ok {
+		return
+	}
+	delete(p.byName, name)
+	for i, existing := range p.accounts {
+		if existing == a {
+			p.accounts = append(p.accounts[:i], p.accounts[i+1:]...)
+			break
+		}
+	}
+}
+
+// Client returns the client of the named account.
+func (p *Pool) Client(name string) (*Client, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	a, ok := p.byName[name]
+	if !ok {
+		return nil, false
+	}
+	return a.client, true
+}
+
+// LoginAll logs in every account that has no session yet, resuming stored sessions
+// where possible. A second factor other than TOTP is asked from Credentials.TwoFactor.
+// It returns the errors of all accounts that could not log in.
+func (p *Pool) LoginAll(ctx context.Context) error {
+	var errs []error
+	for _, a := range p.snapshot() {
+		if a.client.Session() != nil {
+			continue
+		}
+		if err := a.login(ctx); err != nil {
+			a.record(err)
+			errs = append(errs, fmt.Errorf("account %s: %w", a.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Do calls fn with the client of the healthy account with the fewest calls in
+// flight. If every account is unhealthy the least loaded one is used anyway.
+func (p *Pool) Do(fn func(*Client) error) error {
+	var best *poolAccount
+	bestHealthy := false
+	for _, a := range p.snapshot() {
+		healthy := a.healthy()
+		switch {
+		case best == nil,
+			healthy && !bestHealthy,
+			healthy == bestHealthy && a.inFlight.Load() < best.inFlight.Load():
+			best, bestHealthy = a, healthy
+		}
+	}
+	if best == nil {
+		return ErrNoAccounts
+	}
+	return best.do(fn)
+}
+
+// DoAs calls fn with the client of the named account.
+func (p *Pool) DoAs(name string, fn func(*Client) error) error {
+	p.mu.RLock()
+	a, ok := p.byName[name]
+	p.mu.RUnlock()
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownAccount, name)
+	}
+	return a.do(fn)
+}
+
+// Health returns the state of every account, in the order they were added.
+func (p *Pool) Health() []AccountHealth {
+	accounts := p.snapshot()
+	health := make([]AccountHealth, 0, len(accounts))
+	for _, a := range accounts {
+		a.mu.Lock()
+		health = append(health, AccountHealth{
+			Name:          a.name,
+			LoggedIn:      a.client.Session() != nil,
+			Healthy:       a.healthyLocked(),
+			InFlight:      a.inFlight.Load(),
+			Calls:         a.calls,
+			Failures:      a.failures,
+			LastError:     a.lastErr,
+			LastErrorAt:   a.lastErrAt,
+			LastSuccessAt: a.lastSuccessAt,
+		})
+		a.mu.Unlock()
+	}
+	return health
+}
+
+func (p *Pool) snapshot() []*poolAccount {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return append([]*poolAccount(nil), p.accounts...)
+}
+
+func (a *poolAccount) login(ctx context.Context) error {
+	result, err := a.client.LoginWithContext(ctx, a.creds.Username, a.creds.Password)
+	if err != nil || result.User != nil {
+		return err
+	}
+	if a.creds.TwoFactor == nil {
+		return ErrTwoFactorRequired
+	}
+	method, code, err := a.creds.TwoFactor(ctx, result.RequiresTwoFactorAuth)
+	if err != nil {
+		return err
+	}
+	_, err = a.client.CompleteLoginWithContext(ctx, method, code)
+	return err
+}
+
+func (a *poolAccount) do(fn func(*Client) error) error {
+	a.inFlight.Add(1)
+	defer a.inFlight.Add(-1)
+
+	err := fn(a.client)
+	a.record(err)
+	return err
+}
+
+// record counts a call of the account that returned err.
+func (a *poolAccount) record(err error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.calls++
+	switch {
+	case err == nil:
+		a.failuresInRow = 0
+		a.lastSuccessAt = time.Now()
+	case accountFailure(err):
+		a.failures++
+		a.failuresInRow++
+		a.lastErr = err
+		a.lastErrAt = time.Now()
+	default:
+		// The call itself was wrong, e.g. a 404, which says nothing about the account
+		a.failuresInRow = 0
+	}
+}
+
+func (a *poolAccount) healthy() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.healthyLocked()
+}
+
+// healthyLocked reports whether the account may be picked by Pool.Do, a.mu must be held.
+// An unhealthy account becomes healthy after the cool-down, so a burst of failures does
+// not sideline it for good.
+func (a *poolAccount) healthyLocked() bool {
+	return a.failuresInRow < poolUnhealthyAfter || time.Since(a.lastErrAt) >= poolCooldown
+}
+
+// accountFailure reports whether err points at a problem of the account or the API
+// rather than of the call that returned it.
+func accountFailure(err error) bool {
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return true
+	}
+	return errors.Is(err, ErrUnauthorized) || errors.Is(err, ErrRateLimited) || errors.Is(err, ErrServer)
+}
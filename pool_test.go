@@ -0,0 +1,163 @@
+package vrchat
+
+import (
+	"errors"
+	"net/http"
+	"net/http/cookiejar"
+	"testing"
+	"time"
+)
+
+// errNetwork stands for an error pointing at the account or the API.
+var errNetwork = errors.New("connection reset")
+
+func newTestPool(names ...string) *Pool {
+	jar, _ := cookiejar.New(nil)
+	p := NewPool("", "test", WithHTTPClient(&http.Client{}), WithCookieJar(jar), WithRateLimiter(nil))
+	for _, name := range names {
+		p.Add(PoolAccount{Name: name})
+	}
+	return p
+}
+
+// usedAccount returns the name of the account whose client p.Do picks.
+func usedAccount(t *testing.T, p *Pool) string {
+	t.Helper()
+	var used *Client
+	if err := p.Do(func(c *Client) error {
+		used = c
+		return nil
+	}); err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+	for _, health := range p.Health() {
+		if c, _ := p.Client(health.Name); c == used {
+			return health.Name
+		}
+	}
+	t.Fatal("Do used a client of no account")
+	return ""
+}
+
+func healthOf(p *Pool, name string) AccountHealth {
+	for _, health := range p.Health() {
+		if health.Name == name {
+			return health
+		}
+	}
+	return AccountHealth{}
+}
+
+func TestPoolSessionsAreSeparate(t *testing.T) {
+	p := newTestPool("a", "b")
+	a, _ := p.Client("a")
+	b, _ := p.Client("b")
+
+	a.SetSession(&Session{Auth: "authcookie_a"})
+	if session := b.Session(); session != nil {
+		t.Errorf("account b sees the session %+v of account a", session)
+	}
+	b.SetSession(&Session{Auth: "authcookie_b"})
+	if session := a.Session(); session == nil || session.Auth != "authcookie_a" {
+		t.Errorf("session of account a = %+v, want authcookie_a", session)
+	}
+	if a.client.GetClient() == b.client.GetClient() {
+		t.Error("the accounts share their http.Client")
+	}
+}
+
+func TestPoolRouting(t *testing.T) {
+	if err := newTestPool().Do(func(*Client) error { return nil }); !errors.Is(err, ErrNoAccounts) {
+		t.Errorf("Do on an empty pool = %v, want ErrNoAccounts", err)
+	}
+
+	p := newTestPool("a", "b")
+	if err := p.DoAs("c", func(*Client) error { return nil }); !errors.Is(err, ErrUnknownAccount) {
+		t.Errorf("DoAs of an unknown account = %v, want ErrUnknownAccount", err)
+	}
+	b, _ := p.Client("b")
+	if err := p.DoAs("b", func(c *Client) error {
+		if c != b {
+			t.Error("DoAs(b) used another client")
+		}
+		return nil
+	}); err != nil {
+		t.Errorf("DoAs: %v", err)
+	}
+
+	// A call in flight on a sends the next call to b
+	started, release, done := make(chan struct{}), make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(done)
+		p.DoAs("a", func(*Client) error {
+			close(started)
+			<-release
+			return nil
+		})
+	}()
+	<-started
+	if name := usedAccount(t, p); name != "b" {
+		t.Errorf("Do used %s while a was busy, want b", name)
+	}
+	close(release)
+	<-done
+	if name := usedAccount(t, p); name != "a" {
+		t.Errorf("Do used %s while both were idle, want the first account a", name)
+	}
+}
+
+func TestPoolHealth(t *testing.T) {
+	p := newTestPool("a", "b")
+	fail := func(err error) func(*Client) error {
+		return func(*Client) error { return err }
+	}
+
+	// Errors of the call itself do not count against the account
+	for range poolUnhealthyAfter {
+		p.DoAs("a", fail(&APIError{StatusCode: http.StatusNotFound}))
+	}
+	if health := healthOf(p, "a"); !health.Healthy || health.Failures != 0 {
+		t.Errorf("after 404s a = %+v, want healthy without failures", health)
+	}
+
+	for i := range poolUnhealthyAfter {
+		if health := healthOf(p, "a"); !health.Healthy {
+			t.Fatalf("a is unhealthy after %d failures", i)
+		}
+		p.DoAs("a", fail(errNetwork))
+	}
+	health := healthOf(p, "a")
+	if health.Healthy || health.Failures != poolUnhealthyAfter || !errors.Is(health.LastError, errNetwork) {
+		t.Errorf("after %d failures a = %+v, want unhealthy", poolUnhealthyAfter, health)
+	}
+	if name := usedAccount(t, p); name != "b" {
+		t.Errorf("Do used %s, want the healthy account b", name)
+	}
+
+	// After the cool-down a is probed again, and a failure sidelines it at once
+	cooldown := func() {
+		a := p.byName["a"]
+		a.mu.Lock()
+		a.lastErrAt = a.lastErrAt.Add(-poolCooldown)
+		a.mu.Unlock()
+	}
+	cooldown()
+	if !healthOf(p, "a").Healthy {
+		t.Fatal("a is still unhealthy after the cool-down")
+	}
+	if err := p.Do(fail(errNetwork)); !errors.Is(err, errNetwork) {
+		t.Fatalf("Do = %v", err)
+	}
+	if healthOf(p, "a").Healthy {
+		t.Error("a is healthy after its probe failed")
+	}
+
+	cooldown()
+	if name := usedAccount(t, p); name != "a" {
+		t.Fatalf("Do used %s, want a probe of a", name)
+	}
+	health = healthOf(p, "a")
+	if !health.Healthy || health.LastSuccessAt.IsZero() || time.Since(health.LastSuccessAt) > time.Minute {
+		t.Errorf("after a successful probe a = %+v, want healthy", health)
+	}
+}
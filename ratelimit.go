@@ -0,0 +1,134 @@
+package vrchat
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Default pacing of a new Client, VRChat bans clients that keep sending more than a few requests per second.
+const (
+	DefaultRate  = 2.0
+	DefaultBurst = 5
+)
+
+// RateLimiter paces requests with token buckets: one shared by every request and,
+// optionally, one per endpoint group. The group of a request is the first segment
+// of its path, e.g. "users" for /users/{userId} or "auth" for /auth/user/friends.
+//
+// A RateLimiter is safe for concurrent use and may be shared by several clients.
+type RateLimiter struct {
+	global *tokenBucket
+
+	mu     sync.Mutex
+	groups map[string]*tokenBucket
+}
+
+// NewRateLimiter returns a RateLimiter allowing rate requests per second overall,
+// with bursts of up to burst requests. It panics if rate is not positive, use a nil
+// RateLimiter for no limit.
+func NewRateLimiter(rate float64, burst int) *RateLimiter {
+	return &RateLimiter{
+		global: newTokenBucket(rate, burst),
+		groups: make(map[string]*tokenBucket),
+	}
+}
+
+// SetGroupLimit additionally limits the requests of an endpoint group to rate
+// requests per second with bursts of up to burst requests. It panics if rate is not positive.
+func (l *RateLimiter) SetGroupLimit(group string, rate float64, burst int) *RateLimiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.groups[group] = newTokenBucket(rate, burst)
+	return l
+}
+
+// Wait blocks until a request to path is allowed by the global and group limits, or ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context, path string) error {
+	l.mu.Lock()
+	group := l.groups[endpointGroup(path)]
+	l.mu.Unlock()
+
+	if group != nil {
+		if err := group.wait(ctx); err != nil {
+			return err
+		}
+	}
+	if err := l.global.wait(ctx); err != nil {
+		// The request is not sent, so it must not count against its group either
+		if group != nil {
+			group.cancel()
+		}
+		return err
+	}
+	return nil
+}
+
+// endpointGroup returns the first segment of path.
+func endpointGroup(path string) string {
+	group, _, _ := strings.Cut(strings.TrimPrefix(path, "/"), "/")
+	return group
+}
+
+// tokenBucket holds up to burst tokens and refills rate tokens per second.
+type tokenBucket struct {
+	rate  float64
+	burst float64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if !(rate > 0) {
+		panic(fmt.Sprintf("vrchat: invalid rate limit %v, the rate must be positive", rate))
+	}
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// reserve takes a token and returns how long to wait before using it.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel returns a token taken by reserve that was not used.
+func (b *tokenBucket) cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tokens = min(b.burst, b.tokens+1)
+}
+
+func (b *tokenBucket) wait(ctx context.Context) error {
+	delay := b.reserve()
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		b.cancel()
+		return fmt.Errorf("waiting for rate limit: %w", ctx.Err())
+	}
+}
@@ -0,0 +1,94 @@
+package vrchat
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestTokenBucket(t *testing.T) {
+	b := newTokenBucket(10, 3)
+	for i := range 3 {
+		if delay := b.reserve(); delay != 0 {
+			t.Fatalf("request %d of the burst waits %v", i+1, delay)
+		}
+	}
+	if delay := b.reserve(); delay < 90*time.Millisecond || delay > 100*time.Millisecond {
+		t.Errorf("request after the burst waits %v, want 100ms", delay)
+	}
+
+	// 250ms refill 2.5 tokens, one of them owed to the last request
+	b.mu.Lock()
+	b.last = b.last.Add(-250 * time.Millisecond)
+	b.mu.Unlock()
+	if delay := b.reserve(); delay != 0 {
+		t.Errorf("request after the refill waits %v", delay)
+	}
+	if delay := b.reserve(); delay < 40*time.Millisecond || delay > 50*time.Millisecond {
+		t.Errorf("second request after the refill waits %v, want 50ms", delay)
+	}
+
+	// The bucket never holds more than the burst
+	b.mu.Lock()
+	b.last = b.last.Add(-time.Hour)
+	b.mu.Unlock()
+	for range 3 {
+		b.reserve()
+	}
+	if delay := b.reserve(); delay == 0 {
+		t.Error("the bucket refilled beyond its burst")
+	}
+}
+
+func TestRateLimiterCancelRefunds(t *testing.T) {
+	l := NewRateLimiter(1, 1).SetGroupLimit("users", 1, 1)
+	l.global.reserve()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := l.Wait(ctx, "/users/usr_c1644b5b-3ca4-45b4-97c6-a2a0de70d469"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Wait with a cancelled context = %v, want context.Canceled", err)
+	}
+	if delay := l.groups["users"].reserve(); delay != 0 {
+		t.Errorf("the group token taken by the cancelled request was not returned, next request waits %v", delay)
+	}
+	if delay := l.global.reserve(); delay > time.Second {
+		t.Errorf("the global token taken by the cancelled request was not returned, next request waits %v", delay)
+	}
+}
+
+func TestRateLimiterRejectsRates(t *testing.T) {
+	for _, rate := range []float64{0, -1, math.NaN()} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewRateLimiter(%v) did not panic", rate)
+				}
+			}()
+			NewRateLimiter(rate, 1)
+		}()
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("SetGroupLimit(%v) did not panic", rate)
+				}
+			}()
+			NewRateLimiter(1, 1).SetGroupLimit("users", rate, 1)
+		}()
+	}
+}
+
+func TestEndpointGroup(t *testing.T) {
+	for path, group := range map[string]string{
+		"/users/usr_c1644b5b-3ca4-45b4-97c6-a2a0de70d469": "users",
+		"/auth/user/friends":                              "auth",
+		"/config":                                         "config",
+		"":                                                "",
+	} {
+		if got := endpointGroup(path); got != group {
+			t.Errorf("endpointGroup(%q) = %q, want %q", path, got, group)
+		}
+	}
+}
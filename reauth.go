@@ -0,0 +1,84 @@
+package vrchat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrTwoFactorRequired is returned when a re-login requires a second factor and
+// neither a TOTP secret nor a Credentials.TwoFactor callback can provide it.
+var ErrTwoFactorRequired = errors.New("vrchat: two-factor authentication required")
+
+// Credentials are the login details used to log in again when the session expired.
+type Credentials struct {
+	Username string
+	Password string
+	// TwoFactor is called when the login requires a second factor that cannot be
+	// generated from the TOTP secret of the client. It returns the chosen method and its code.
+	TwoFactor func(ctx context.Context, methods []TwoFactorMethod) (TwoFactorMethod, string, error)
+}
+
+// CredentialsProvider returns the credentials to log in with, it is called for every re-login.
+type CredentialsProvider func(ctx context.Context) (*Credentials, error)
+
+// StaticCredentials returns a CredentialsProvider always returning creds.
+func StaticCredentials(creds Credentials) CredentialsProvider {
+	return func(ctx context.Context) (*Credentials, error) {
+		return &creds, nil
+	}
+}
+
+// reauthenticationKey marks contexts of requests that must not trigger a re-login.
+type reauthenticationKey struct{}
+
+func withoutReauthentication(ctx context.Context) context.Context {
+	return context.WithValue(ctx, reauthenticationKey{}, true)
+}
+
+// canReauthenticate reports whether a 401 of a request sent with ctx may be answered by logging in again.
+func (c *Client) canReauthenticate(ctx context.Context) bool {
+	return c.credentials != nil && ctx.Value(reauthenticationKey{}) == nil
+}
+
+// reauthenticate logs in again after a request sent during the given login
+// generation got a 401. Concurrent calls are serialized, and only the first of the
+// requests failing with the same session logs in again.
+func (c *Client) reauthenticate(ctx context.Context, generation uint64) error {
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+	if c.authGeneration.Load() != generation {
+		return nil
+	}
+
+	ctx = withoutReauthentication(ctx)
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return fmt.Errorf("vrchat: re-authenticating: %w", err)
+	}
+	result, err := c.login(ctx, creds.Username, creds.Password)
+	if err != nil {
+		return fmt.Errorf("vrchat: re-authenticating: %w", err)
+	}
+	if result.User != nil {
+		return nil
+	}
+
+	if creds.TwoFactor == nil {
+		return fmt.Errorf("vrchat: re-authenticating: %w", ErrTwoFactorRequired)
+	}
+	method, code, err := creds.TwoFactor(ctx, result.RequiresTwoFactorAuth)
+	if err != nil {
+		return fmt.Errorf("vrchat: re-authenticating: %w", err)
+	}
+	if _, err := c.completeLogin(ctx, method, code); err != nil {
+		return fmt.Errorf("vrchat: re-authenticating: %w", err)
+	}
+	return nil
+}
+
+// loggedIn records a successful login and saves the new session.
+func (c *Client) loggedIn(ctx context.Context) error {
+	c.authGeneration.Add(1)
+	return c.saveSession(ctx)
+}
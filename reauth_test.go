@@ -0,0 +1,158 @@
+package vrchat
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const testUser = `{"id":"usr_c1644b5b-3ca4-45b4-97c6-a2a0de70d469","displayName":"test"}`
+
+// authServer is a fake API handing out auth cookies. The "manual" account requires
+// a TOTP code, every other account logs in directly.
+type authServer struct {
+	mu     sync.Mutex
+	tokens int
+	valid  map[string]bool
+	// relogins counts the logins of the "bot" account, redundant those replacing a valid session
+	relogins  int
+	redundant int
+}
+
+func newAuthServer() *authServer {
+	return &authServer{valid: make(map[string]bool)}
+}
+
+// expire invalidates every session.
+func (s *authServer) expire() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	clear(s.valid)
+}
+
+func (s *authServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var session string
+	if cookie, err := r.Cookie(AuthCookie); err == nil {
+		session = cookie.Value
+	}
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case r.URL.Path == "/auth/user" && r.Header.Get("Authorization") != "":
+		username, _, _ := r.BasicAuth()
+		s.tokens++
+		token := fmt.Sprintf("authcookie_%d", s.tokens)
+		http.SetCookie(w, &http.Cookie{Name: AuthCookie, Value: token, Path: "/"})
+		if username == "manual" {
+			fmt.Fprint(w, `{"requiresTwoFactorAuth":["totp"]}`)
+			return
+		}
+		if username == "bot" {
+			s.relogins++
+			if s.valid[session] {
+				s.redundant++
+			}
+		}
+		s.valid[token] = true
+		fmt.Fprint(w, testUser)
+	case r.URL.Path == "/auth/twofactorauth/totp/verify":
+		s.valid[session] = true
+		fmt.Fprint(w, `{"verified":true}`)
+	case !s.valid[session]:
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"error":{"message":"\"Missing Credentials\"","status_code":401}}`)
+	case r.URL.Path == "/auth/user" || strings.HasPrefix(r.URL.Path, "/users/"):
+		fmt.Fprint(w, testUser)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// TestReauthenticateOnce sends requests while the session keeps expiring and the
+// caller logs in by hand, and checks that the requests failing with the same session
+// log in again only once: a second re-login would replace a session that is valid.
+// Run it with -race.
+func TestReauthenticateOnce(t *testing.T) {
+	api := newAuthServer()
+	server := httptest.NewServer(api)
+	defer server.Close()
+
+	c := NewClient(server.URL, "test",
+		WithRateLimiter(nil),
+		WithLogger(discardLogger{}),
+		WithCredentials(StaticCredentials(Credentials{Username: "bot", Password: "secret"})),
+	)
+	if _, err := c.Login("bot", "secret"); err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+
+	var (
+		stop     atomic.Bool
+		requests sync.WaitGroup
+	)
+	errs := make(chan error, 8)
+	for range 8 {
+		requests.Go(func() {
+			for !stop.Load() {
+				_, err := c.GetUser(GetUserParams{UserId: "usr_c1644b5b-3ca4-45b4-97c6-a2a0de70d469"})
+				// A request can still be rejected when its retry races with another expiry
+				// or with the unverified session of a manual login
+				if err != nil && !errors.Is(err, ErrUnauthorized) {
+					errs <- err
+					return
+				}
+			}
+		})
+	}
+
+	for range 20 {
+		api.expire()
+		time.Sleep(2 * time.Millisecond)
+		result, err := c.Login("manual", "secret")
+		if err != nil {
+			t.Fatalf("Login: %v", err)
+		}
+		if !result.Requires(TwoFactorTOTP) {
+			t.Fatalf("Login = %+v, want a TOTP factor", result)
+		}
+		if _, err := c.CompleteLogin(TwoFactorTOTP, "123456"); err != nil {
+			t.Fatalf("CompleteLogin: %v", err)
+		}
+		api.expire()
+		time.Sleep(2 * time.Millisecond)
+	}
+	stop.Store(true)
+	requests.Wait()
+	close(errs)
+	for err := range errs {
+		t.Errorf("GetUser: %v", err)
+	}
+
+	if _, err := c.GetUser(GetUserParams{UserId: "usr_c1644b5b-3ca4-45b4-97c6-a2a0de70d469"}); err != nil {
+		t.Errorf("GetUser after the last expiry: %v", err)
+	}
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	// The first login is not a re-login
+	if api.relogins < 2 {
+		t.Errorf("logged in again %d times, want a re-login after the expiries", api.relogins-1)
+	}
+	if api.redundant > 0 {
+		t.Errorf("%d of %d re-logins replaced a valid session", api.redundant, api.relogins-1)
+	}
+}
+
+// discardLogger drops the warnings resty logs for basic auth over plain HTTP.
+type discardLogger struct{}
+
+func (discardLogger) Errorf(format string, v ...any) {}
+func (discardLogger) Warnf(format string, v ...any)  {}
+func (discardLogger) Debugf(format string, v ...any) {}
@@ -0,0 +1,74 @@
+package vrchat
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+)
+
+// Reconnect delays used when the API config cannot be fetched.
+const (
+	defaultQuickReconnectTime     = 2 * time.Second
+	defaultReconnectMaxDelay      = time.Minute
+	defaultMaxFriendsRefreshDelay = 15 * time.Minute
+)
+
+// reconnectDelays are the websocket settings of the API config, given there in seconds.
+type reconnectDelays struct {
+	// quick is the delay before the first reconnect attempt
+	quick time.Duration
+	// max caps the delay between two reconnect attempts
+	max time.Duration
+	// maxRefresh caps the random delay before refreshing state after an outage
+	maxRefresh time.Duration
+}
+
+// reconnectDelays fetches the reconnect delays from the API config, falling back to
+// defaults for those it cannot get.
+func (c *Client) reconnectDelays(ctx context.Context) *reconnectDelays {
+	d := &reconnectDelays{
+		quick:      defaultQuickReconnectTime,
+		max:        defaultReconnectMaxDelay,
+		maxRefresh: defaultMaxFriendsRefreshDelay,
+	}
+	config, err := c.GetConfigWithContext(ctx)
+	if err != nil {
+		return d
+	}
+	if config.WebsocketQuickReconnectTime > 0 {
+		d.quick = time.Duration(config.WebsocketQuickReconnectTime) * time.Second
+	}
+	if config.WebsocketReconnectMaxDelay > 0 {
+		d.max = time.Duration(config.WebsocketReconnectMaxDelay) * time.Second
+	}
+	if config.WebsocketMaxFriendsRefreshDelay > 0 {
+		d.maxRefresh = time.Duration(config.WebsocketMaxFriendsRefreshDelay) * time.Second
+	}
+	d.max = max(d.max, d.quick)
+	return d
+}
+
+// backoff returns the delay before the given reconnect attempt (0 for the first). It
+// starts at the quick reconnect time and doubles up to the maximum, with jitter.
+func (d *reconnectDelays) backoff(attempt int) time.Duration {
+	if attempt == 0 {
+		return d.quick
+	}
+	ceiling := d.max
+	if shift := uint(attempt); shift < 32 && d.quick<<shift < d.max {
+		ceiling = d.quick << shift
+	}
+	if ceiling <= d.quick {
+		return d.quick
+	}
+	return d.quick + rand.N(ceiling-d.quick)
+}
+
+// refreshDelay returns the delay before refreshing state after reconnecting, none if
+// the first attempt to reconnect succeeded.
+func (d *reconnectDelays) refreshDelay(quick bool) time.Duration {
+	if quick || d.maxRefresh <= 0 {
+		return 0
+	}
+	return rand.N(d.maxRefresh)
+}
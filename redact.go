@@ -0,0 +1,105 @@
+package vrchat
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// redacted replaces secrets in logs and error messages.
+const redacted = "[REDACTED]"
+
+// redactedFields are JSON fields whose values are never logged: passwords (e.g. of
+// UpdateUserRequest), OTP codes, TOTP secrets, tokens and email addresses.
+var redactedFields = map[string]bool{
+	"password":               true,
+	"currentpassword":        true,
+	"code":                   true,
+	"secret":                 true,
+	"token":                  true,
+	"authtoken":              true,
+	"auth":                   true,
+	"twofactorauth":          true,
+	"email":                  true,
+	"pendingemail":           true,
+	"obfuscatedemail":        true,
+	"obfuscatedpendingemail": true,
+}
+
+var (
+	emailAddress = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+	// authCookie also matches the authToken query parameter carrying the session to the pipeline
+	authCookie = regexp.MustCompile(`\b(auth|twoFactorAuth|authToken)=[^;&\s"]*`)
+)
+
+// redactHeader returns a copy of h without credentials and session cookies.
+func redactHeader(h http.Header) http.Header {
+	h = h.Clone()
+	if h.Get("Authorization") != "" {
+		h.Set("Authorization", redacted)
+	}
+	for _, key := range []string{"Cookie", "Set-Cookie"} {
+		for i, value := range h.Values(key) {
+			h[http.CanonicalHeaderKey(key)][i] = authCookie.ReplaceAllString(value, "${1}="+redacted)
+		}
+	}
+	return h
+}
+
+// redactBody removes secrets and email addresses from a request or response body.
+// JSON bodies have the values of redactedFields replaced, anything else only has
+// session cookies and email addresses replaced.
+func redactBody(body string) string {
+	var value any
+	if err := json.Unmarshal([]byte(body), &value); err == nil {
+		if data, err := json.Marshal(redactValue(value)); err == nil {
+			body = string(data)
+		}
+	}
+	body = authCookie.ReplaceAllString(body, "${1}="+redacted)
+	return emailAddress.ReplaceAllString(body, redacted)
+}
+
+func redactValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if redactedFields[strings.ToLower(key)] {
+				v[key] = redacted
+			} else {
+				v[key] = redactValue(field)
+			}
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+	}
+	return value
+}
+
+// truncate shortens s to at most n bytes for logging.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n] + "..."
+}
+
+// redactDebugLogs keeps the debug output of resty, enabled with GetClient().SetDebug,
+// free of credentials, session cookies, OTP codes and email addresses.
+func redactDebugLogs(client *resty.Client) {
+	client.OnRequestLog(func(l *resty.RequestLog) error {
+		l.Header = redactHeader(l.Header)
+		l.Body = redactBody(l.Body)
+		return nil
+	})
+	client.OnResponseLog(func(l *resty.ResponseLog) error {
+		l.Header = redactHeader(l.Header)
+		l.Body = redactBody(l.Body)
+		return nil
+	})
+}
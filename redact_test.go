@@ -0,0 +1,119 @@
+package vrchat
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// bufferLogger collects what resty logs, including its debug output.
+type bufferLogger struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (l *bufferLogger) Errorf(format string, v ...any) { l.printf(format, v...) }
+func (l *bufferLogger) Warnf(format string, v ...any)  { l.printf(format, v...) }
+func (l *bufferLogger) Debugf(format string, v ...any) { l.printf(format, v...) }
+
+func (l *bufferLogger) printf(format string, v ...any) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	fmt.Fprintf(&l.buf, format+"\n", v...)
+}
+
+func (l *bufferLogger) String() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.buf.String()
+}
+
+// TestRedaction sends a request carrying every kind of secret to a server answering
+// with more of them, and checks that none shows up in resty's debug output, the slog
+// records or the error.
+func TestRedaction(t *testing.T) {
+	secrets := []struct {
+		name   string
+		secret string
+	}{
+		{"Authorization header", base64.StdEncoding.EncodeToString([]byte("player:hunter2-basic"))},
+		{"auth in Cookie", "authcookie_request-secret"},
+		{"twoFactorAuth in Cookie", "tfa-request-secret"},
+		{"auth in Set-Cookie", "authcookie_response-secret"},
+		{"twoFactorAuth in Set-Cookie", "tfa-response-secret"},
+		{"password in the request", "hunter2-json"},
+		{"code in the request", "987654"},
+		{"password in the response", "hunter2-response"},
+		{"code in the response", "135790"},
+		{"email in the request", "player@example.com"},
+		{"email in the response body", "friend@example.org"},
+		{"email in the error message", "support@example.net"},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: AuthCookie, Value: "authcookie_response-secret", Path: "/"})
+		http.SetCookie(w, &http.Cookie{Name: TwoFactorAuthCookie, Value: "tfa-response-secret", Path: "/"})
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"error":{"message":"code rejected, contact support@example.net","status_code":400},`+
+			`"password":"hunter2-response","code":"135790","friend":{"email":"friend@example.org"}}`)
+	}))
+	defer server.Close()
+
+	var records bytes.Buffer
+	debug := new(bufferLogger)
+	logger := slog.New(slog.NewTextHandler(&records, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	c := NewClient(server.URL, "test",
+		WithRateLimiter(nil),
+		WithRetryPolicy(RetryPolicy{}),
+		WithLogger(debug),
+		WithSlog(logger, LogOptions{ErrorLevel: slog.LevelWarn, Bodies: true}),
+	)
+	c.client.SetDebug(true)
+	c.SetSession(&Session{Auth: "authcookie_request-secret", TwoFactorAuth: "tfa-request-secret"})
+
+	req := c.newRequest(context.Background(), "Test", "/test").
+		SetBasicAuth("player", "hunter2-basic").
+		SetBody(map[string]any{
+			"password": "hunter2-json",
+			"code":     "987654",
+			"contact":  "player@example.com",
+		})
+	resp, err := c.send(req, resty.MethodPost, "/test")
+	if err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	apiErr := newAPIError("Test", resp).Error()
+
+	outputs := []struct {
+		name   string
+		output string
+	}{
+		{"resty debug output", debug.String()},
+		{"slog records", records.String()},
+		{"APIError.Error", apiErr},
+	}
+	for _, output := range outputs {
+		if !strings.Contains(output.output, redacted) {
+			t.Errorf("%s holds nothing redacted:\n%s", output.name, output.output)
+		}
+	}
+	for _, secret := range secrets {
+		t.Run(secret.name, func(t *testing.T) {
+			for _, output := range outputs {
+				if strings.Contains(output.output, secret.secret) {
+					t.Errorf("%s holds %q:\n%s", output.name, secret.secret, output.output)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package vrchat
+
+import (
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// RetryPolicy decides which failed requests are sent again and how long to wait in between.
+//
+// 429 Too Many Requests is retried for every method, the API rejected the request
+// without acting on it. 502 Bad Gateway and 503 Service Unavailable may come after
+// the API acted on the request, so they are only retried for GET and HEAD requests
+// unless RetryUnsafe is set, which keeps POST, PUT and DELETE from being replayed.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt, 0 disables retrying.
+	MaxRetries int
+	// BaseDelay is the delay before the first retry, it doubles with every further retry.
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between two attempts. A Retry-After longer than MaxDelay
+	// is not waited for, the response is returned instead.
+	MaxDelay time.Duration
+	// RetryUnsafe also retries POST, PUT and DELETE requests on 502 and 503.
+	RetryUnsafe bool
+}
+
+// DefaultRetryPolicy is the retry policy of a new Client.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	BaseDelay:  time.Second,
+	MaxDelay:   time.Minute,
+}
+
+// backoff returns the delay before retrying a request that got resp on the given
+// attempt (0 for the first), ok is false if it must not be retried.
+func (p RetryPolicy) backoff(attempt int, method string, resp *resty.Response) (delay time.Duration, ok bool) {
+	if attempt >= p.MaxRetries {
+		return 0, false
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusTooManyRequests:
+	case http.StatusBadGateway, http.StatusServiceUnavailable:
+		if method != resty.MethodGet && method != resty.MethodHead && !p.RetryUnsafe {
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+
+	if after, ok := retryAfter(resp.Header().Get("Retry-After")); ok {
+		return after, after <= p.MaxDelay
+	}
+
+	// Full jitter: a random delay up to the exponentially growing ceiling
+	ceiling := p.MaxDelay
+	if shift := uint(attempt); shift < 32 && p.BaseDelay<<shift < p.MaxDelay {
+		ceiling = p.BaseDelay << shift
+	}
+	if ceiling <= 0 {
+		return 0, true
+	}
+	return rand.N(ceiling) + 1, true
+}
+
+// retryAfter parses a Retry-After header, given in seconds or as an HTTP date.
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return max(time.Until(at), 0), true
+	}
+	return 0, false
+}
@@ -0,0 +1,100 @@
+package vrchat
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+func testResponse(status int, retryAfter string) *resty.Response {
+	header := http.Header{}
+	if retryAfter != "" {
+		header.Set("Retry-After", retryAfter)
+	}
+	return &resty.Response{RawResponse: &http.Response{StatusCode: status, Header: header}}
+}
+
+func TestRetryPolicyStatuses(t *testing.T) {
+	policy := RetryPolicy{MaxRetries: 3, BaseDelay: time.Second, MaxDelay: time.Minute}
+	unsafe := policy
+	unsafe.RetryUnsafe = true
+	tests := []struct {
+		policy RetryPolicy
+		method string
+		status int
+		retry  bool
+	}{
+		{policy, resty.MethodGet, http.StatusTooManyRequests, true},
+		{policy, resty.MethodPost, http.StatusTooManyRequests, true},
+		{policy, resty.MethodGet, http.StatusBadGateway, true},
+		{policy, resty.MethodHead, http.StatusServiceUnavailable, true},
+		{policy, resty.MethodPost, http.StatusBadGateway, false},
+		{policy, resty.MethodPut, http.StatusServiceUnavailable, false},
+		{policy, resty.MethodDelete, http.StatusBadGateway, false},
+		{unsafe, resty.MethodPost, http.StatusServiceUnavailable, true},
+		{policy, resty.MethodGet, http.StatusInternalServerError, false},
+		{policy, resty.MethodGet, http.StatusGatewayTimeout, false},
+		{policy, resty.MethodGet, http.StatusNotFound, false},
+		{RetryPolicy{}, resty.MethodGet, http.StatusTooManyRequests, false},
+	}
+	for _, test := range tests {
+		if _, ok := test.policy.backoff(0, test.method, testResponse(test.status, "")); ok != test.retry {
+			t.Errorf("%s %d with RetryUnsafe %v retried: %v, want %v", test.method, test.status, test.policy.RetryUnsafe, ok, test.retry)
+		}
+	}
+
+	if _, ok := policy.backoff(3, resty.MethodGet, testResponse(http.StatusTooManyRequests, "")); ok {
+		t.Error("retried after MaxRetries")
+	}
+}
+
+func TestRetryPolicyRetryAfter(t *testing.T) {
+	policy := RetryPolicy{MaxRetries: 3, BaseDelay: time.Second, MaxDelay: time.Minute}
+	retry := func(retryAfter string) (time.Duration, bool) {
+		return policy.backoff(0, resty.MethodGet, testResponse(http.StatusTooManyRequests, retryAfter))
+	}
+
+	if delay, ok := retry("7"); !ok || delay != 7*time.Second {
+		t.Errorf("Retry-After: 7 waits %v, %v, want 7s", delay, ok)
+	}
+	if delay, ok := retry("0"); !ok || delay != 0 {
+		t.Errorf("Retry-After: 0 waits %v, %v, want no delay", delay, ok)
+	}
+	if _, ok := retry("120"); ok {
+		t.Error("retried after a Retry-After longer than MaxDelay")
+	}
+
+	date := time.Now().Add(30 * time.Second).UTC().Format(http.TimeFormat)
+	if delay, ok := retry(date); !ok || delay < 28*time.Second || delay > 30*time.Second {
+		t.Errorf("Retry-After: %s waits %v, %v, want about 30s", date, delay, ok)
+	}
+	past := time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat)
+	if delay, ok := retry(past); !ok || delay != 0 {
+		t.Errorf("Retry-After in the past waits %v, %v, want no delay", delay, ok)
+	}
+
+	// An unparsable Retry-After falls back to the backoff
+	if delay, ok := retry("soon"); !ok || delay <= 0 || delay > time.Second {
+		t.Errorf("invalid Retry-After waits %v, %v, want the backoff of the first retry", delay, ok)
+	}
+}
+
+func TestRetryPolicyJitter(t *testing.T) {
+	policy := RetryPolicy{MaxRetries: 10, BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+	for attempt := range 8 {
+		ceiling := min(policy.BaseDelay<<attempt, policy.MaxDelay)
+		var longest time.Duration
+		for range 1000 {
+			delay, ok := policy.backoff(attempt, resty.MethodGet, testResponse(http.StatusServiceUnavailable, ""))
+			if !ok || delay <= 0 || delay > ceiling {
+				t.Fatalf("attempt %d waits %v, %v, want up to %v", attempt, delay, ok, ceiling)
+			}
+			longest = max(longest, delay)
+		}
+		if longest < ceiling/2 {
+			t.Errorf("attempt %d waits at most %v of %v, the delays are not spread", attempt, longest, ceiling)
+		}
+	}
+}
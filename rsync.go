@@ -0,0 +1,62 @@
+package vrchat
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+// Parameters of the librsync signatures uploaded with a file version, the defaults of librsync.
+const (
+	rsyncBlake2Magic   = 0x72730137
+	rsyncBlockLength   = 2048
+	rsyncStrongSumSize = 32
+	rsyncCharOffset    = 31
+)
+
+// writeSignature writes the librsync signature (BLAKE2 strong sums) of the content of r to w.
+func writeSignature(w io.Writer, r io.Reader) error {
+	bw := bufio.NewWriter(w)
+	var header [12]byte
+	binary.BigEndian.PutUint32(header[0:], rsyncBlake2Magic)
+	binary.BigEndian.PutUint32(header[4:], rsyncBlockLength)
+	binary.BigEndian.PutUint32(header[8:], rsyncStrongSumSize)
+	if _, err := bw.Write(header[:]); err != nil {
+		return err
+	}
+
+	block := make([]byte, rsyncBlockLength)
+	for {
+		n, err := io.ReadFull(r, block)
+		if n > 0 {
+			var weak [4]byte
+			binary.BigEndian.PutUint32(weak[:], rollsum(block[:n]))
+			strong := blake2b.Sum256(block[:n])
+			if _, err := bw.Write(weak[:]); err != nil {
+				return err
+			}
+			if _, err := bw.Write(strong[:rsyncStrongSumSize]); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// rollsum returns the librsync rolling checksum of a block.
+func rollsum(block []byte) uint32 {
+	var s1, s2 uint32
+	for _, b := range block {
+		s1 += uint32(b) + rsyncCharOffset
+		s2 += s1
+	}
+	return s2<<16 | s1&0xffff
+}
@@ -560,18 +560,18 @@ type AvatarStyle struct {
 }
 
 type UpdateAvatarRequest struct {
-	AssetUrl      string        `json:"assetUrl,omitempty"`
-	Description   string        `json:"description,omitempty"`
-	Id            AvatarId      `json:"id,omitempty"`
-	ImageUrl      string        `json:"imageUrl,omitempty"`
-	Name          string        `json:"name,omitempty"`
-	ReleaseStatus ReleaseStatus `json:"releaseStatus,omitempty"`
+	AssetUrl      Optional[string]        `json:"assetUrl,omitzero"`
+	Description   Optional[string]        `json:"description,omitzero"`
+	Id            Optional[AvatarId]      `json:"id,omitzero"`
+	ImageUrl      Optional[string]        `json:"imageUrl,omitzero"`
+	Name          Optional[string]        `json:"name,omitzero"`
+	ReleaseStatus Optional[ReleaseStatus] `json:"releaseStatus,omitzero"`
 
 	// Tags
-	Tags            []Tag  `json:"tags,omitempty"`
-	UnityPackageUrl string `json:"unityPackageUrl,omitempty"`
-	UnityVersion    string `json:"unityVersion,omitempty"`
-	Version         int64  `json:"version,omitempty"`
+	Tags            Optional[[]Tag]  `json:"tags,omitzero"`
+	UnityPackageUrl Optional[string] `json:"unityPackageUrl,omitzero"`
+	UnityVersion    Optional[string] `json:"unityVersion,omitzero"`
+	Version         Optional[int64]  `json:"version,omitzero"`
 }
 
 // ServiceStatus Status information for a service request
@@ -692,32 +692,32 @@ type CreateCalendarEventRequest struct { // AccessType enum
 }
 
 type UpdateCalendarEventRequest struct {
-	Category                     string `json:"category,omitempty"`
-	CloseInstanceAfterEndMinutes int64  `json:"closeInstanceAfterEndMinutes,omitempty"`
-	Description                  string `json:"description,omitempty"`
+	Category                     Optional[string] `json:"category,omitzero"`
+	CloseInstanceAfterEndMinutes Optional[int64]  `json:"closeInstanceAfterEndMinutes,omitzero"`
+	Description                  Optional[string] `json:"description,omitzero"`
 
 	// EndsAt Time the vent starts at
-	EndsAt                time.Time `json:"endsAt,omitempty"`
-	Featured              bool      `json:"featured,omitempty"`
-	GuestEarlyJoinMinutes int64     `json:"guestEarlyJoinMinutes,omitempty"`
-	HostEarlyJoinMinutes  int64     `json:"hostEarlyJoinMinutes,omitempty"`
-	ImageId               FileId    `json:"imageId,omitempty"`
-	IsDraft               bool      `json:"isDraft,omitempty"`
-	Languages             []string  `json:"languages,omitempty"`
-	ParentId              string    `json:"parentId,omitempty"`
-	Platforms             []string  `json:"platforms,omitempty"`
-	RoleIds               []string  `json:"roleIds,omitempty"`
+	EndsAt                Optional[time.Time] `json:"endsAt,omitzero"`
+	Featured              Optional[bool]      `json:"featured,omitzero"`
+	GuestEarlyJoinMinutes Optional[int64]     `json:"guestEarlyJoinMinutes,omitzero"`
+	HostEarlyJoinMinutes  Optional[int64]     `json:"hostEarlyJoinMinutes,omitzero"`
+	ImageId               Optional[FileId]    `json:"imageId,omitzero"`
+	IsDraft               Optional[bool]      `json:"isDraft,omitzero"`
+	Languages             Optional[[]string]  `json:"languages,omitzero"`
+	ParentId              Optional[string]    `json:"parentId,omitzero"`
+	Platforms             Optional[[]string]  `json:"platforms,omitzero"`
+	RoleIds               Optional[[]string]  `json:"roleIds,omitzero"`
 
 	// SendCreationNotification Send notification to group members.
-	SendCreationNotification bool `json:"sendCreationNotification,omitempty"`
+	SendCreationNotification Optional[bool] `json:"sendCreationNotification,omitzero"`
 
 	// StartsAt Time the vent starts at
-	StartsAt time.Time `json:"startsAt,omitempty"`
-	Tags     []string  `json:"tags,omitempty"`
+	StartsAt Optional[time.Time] `json:"startsAt,omitzero"`
+	Tags     Optional[[]string]  `json:"tags,omitzero"`
 
 	// Title Event title
-	Title                string `json:"title,omitempty"`
-	UsesInstanceOverflow bool   `json:"usesInstanceOverflow,omitempty"`
+	Title                Optional[string] `json:"title,omitzero"`
+	UsesInstanceOverflow Optional[bool]   `json:"usesInstanceOverflow,omitzero"`
 }
 
 type FollowCalendarEventRequest struct {
@@ -1130,11 +1130,11 @@ type FavoriteGroup struct {
 }
 
 type UpdateFavoriteGroupRequest struct {
-	DisplayName string `json:"displayName,omitempty"`
+	DisplayName Optional[string] `json:"displayName,omitzero"`
 
 	// Tags Tags on FavoriteGroups are believed to do nothing.
-	Tags       []Tag                   `json:"tags,omitempty"`
-	Visibility FavoriteGroupVisibility `json:"visibility,omitempty"`
+	Tags       Optional[[]Tag]                   `json:"tags,omitzero"`
+	Visibility Optional[FavoriteGroupVisibility] `json:"visibility,omitzero"`
 }
 
 type FavoriteGroupLimits struct {
@@ -1668,20 +1668,20 @@ type GroupRoleTemplateValues struct {
 }
 
 type UpdateGroupRequest struct {
-	BannerId    string         `json:"bannerId,omitempty"`
-	Description string         `json:"description,omitempty"`
-	IconId      string         `json:"iconId,omitempty"`
-	JoinState   GroupJoinState `json:"joinState,omitempty"`
+	BannerId    Optional[string]         `json:"bannerId,omitzero"`
+	Description Optional[string]         `json:"description,omitzero"`
+	IconId      Optional[string]         `json:"iconId,omitzero"`
+	JoinState   Optional[GroupJoinState] `json:"joinState,omitzero"`
 
 	// Languages 3 letter language code
-	Languages []string `json:"languages,omitempty"`
-	Links     []string `json:"links,omitempty"`
-	Name      string   `json:"name,omitempty"`
-	Rules     string   `json:"rules,omitempty"`
-	ShortCode string   `json:"shortCode,omitempty"`
+	Languages Optional[[]string] `json:"languages,omitzero"`
+	Links     Optional[[]string] `json:"links,omitzero"`
+	Name      Optional[string]   `json:"name,omitzero"`
+	Rules     Optional[string]   `json:"rules,omitzero"`
+	ShortCode Optional[string]   `json:"shortCode,omitzero"`
 
 	// Tags
-	Tags []Tag `json:"tags,omitempty"`
+	Tags Optional[[]Tag] `json:"tags,omitzero"`
 }
 
 type GroupAnnouncementId string
@@ -1843,25 +1843,25 @@ type GroupGalleryImage struct {
 
 type UpdateGroupGalleryRequest struct {
 	// Description Description of the gallery.
-	Description string `json:"description,omitempty"`
+	Description Optional[string] `json:"description,omitzero"`
 
 	// MembersOnly Whether the gallery is members only.
-	MembersOnly bool `json:"membersOnly,omitempty"`
+	MembersOnly Optional[bool] `json:"membersOnly,omitzero"`
 
 	// Name Name of the gallery.
-	Name string `json:"name,omitempty"`
+	Name Optional[string] `json:"name,omitzero"`
 
 	// RoleIdsToAutoApprove
-	RoleIdsToAutoApprove []GroupRoleId `json:"roleIdsToAutoApprove,omitempty"`
+	RoleIdsToAutoApprove Optional[[]GroupRoleId] `json:"roleIdsToAutoApprove,omitzero"`
 
 	// RoleIdsToManage
-	RoleIdsToManage []GroupRoleId `json:"roleIdsToManage,omitempty"`
+	RoleIdsToManage Optional[[]GroupRoleId] `json:"roleIdsToManage,omitzero"`
 
 	// RoleIdsToSubmit
-	RoleIdsToSubmit []GroupRoleId `json:"roleIdsToSubmit,omitempty"`
+	RoleIdsToSubmit Optional[[]GroupRoleId] `json:"roleIdsToSubmit,omitzero"`
 
 	// RoleIdsToView
-	RoleIdsToView []GroupRoleId `json:"roleIdsToView,omitempty"`
+	RoleIdsToView Optional[[]GroupRoleId] `json:"roleIdsToView,omitzero"`
 }
 
 type AddGroupGalleryImageRequest struct {
@@ -1998,10 +1998,10 @@ const (
 )
 
 type UpdateGroupMemberRequest struct {
-	IsSubscribedToAnnouncements      bool                `json:"isSubscribedToAnnouncements,omitempty"`
-	IsSubscribedToEventAnnouncements bool                `json:"isSubscribedToEventAnnouncements,omitempty"`
-	ManagerNotes                     string              `json:"managerNotes,omitempty"`
-	Visibility                       GroupUserVisibility `json:"visibility,omitempty"`
+	IsSubscribedToAnnouncements      Optional[bool]                `json:"isSubscribedToAnnouncements,omitzero"`
+	IsSubscribedToEventAnnouncements Optional[bool]                `json:"isSubscribedToEventAnnouncements,omitzero"`
+	ManagerNotes                     Optional[string]              `json:"managerNotes,omitzero"`
+	Visibility                       Optional[GroupUserVisibility] `json:"visibility,omitzero"`
 }
 
 // GroupRoleIdList
@@ -2094,11 +2094,11 @@ type CreateGroupRoleRequest struct {
 }
 
 type UpdateGroupRoleRequest struct {
-	Description      string             `json:"description,omitempty"`
-	IsSelfAssignable bool               `json:"isSelfAssignable,omitempty"`
-	Name             string             `json:"name,omitempty"`
-	Order            int64              `json:"order,omitempty"`
-	Permissions      []GroupPermissions `json:"permissions,omitempty"`
+	Description      Optional[string]             `json:"description,omitzero"`
+	IsSelfAssignable Optional[bool]               `json:"isSelfAssignable,omitzero"`
+	Name             Optional[string]             `json:"name,omitzero"`
+	Order            Optional[int64]              `json:"order,omitzero"`
+	Permissions      Optional[[]GroupPermissions] `json:"permissions,omitzero"`
 }
 
 type InventoryItemType string
@@ -2170,7 +2170,7 @@ type Inventory struct {
 }
 
 type UpdateInventoryItemRequest struct {
-	IsArchived bool `json:"isArchived,omitempty"`
+	IsArchived Optional[bool] `json:"isArchived,omitzero"`
 }
 
 type InventoryDropId string
@@ -2784,37 +2784,37 @@ type User struct {
 }
 
 type UpdateUserRequest struct {
-	AcceptedTosVersion int64    `json:"acceptedTOSVersion,omitempty"`
-	Bio                string   `json:"bio,omitempty"`
-	BioLinks           []string `json:"bioLinks,omitempty"`
-	Birthday           string   `json:"birthday,omitempty"`
+	AcceptedTosVersion Optional[int64]    `json:"acceptedTOSVersion,omitzero"`
+	Bio                Optional[string]   `json:"bio,omitzero"`
+	BioLinks           Optional[[]string] `json:"bioLinks,omitzero"`
+	Birthday           Optional[string]   `json:"birthday,omitzero"`
 
 	// ContentFilters These tags begin with `content_` and control content gating
-	ContentFilters  []Tag  `json:"contentFilters,omitempty"`
-	CurrentPassword string `json:"currentPassword,omitempty"`
+	ContentFilters  Optional[[]Tag]  `json:"contentFilters,omitzero"`
+	CurrentPassword Optional[string] `json:"currentPassword,omitzero"`
 
 	// DisplayName MUST specify currentPassword as well to change display name
-	DisplayName      string `json:"displayName,omitempty"`
-	Email            string `json:"email,omitempty"`
-	IsBoopingEnabled bool   `json:"isBoopingEnabled,omitempty"`
+	DisplayName      Optional[string] `json:"displayName,omitzero"`
+	Email            Optional[string] `json:"email,omitzero"`
+	IsBoopingEnabled Optional[bool]   `json:"isBoopingEnabled,omitzero"`
 
 	// Password MUST specify currentPassword as well to change password
-	Password string `json:"password,omitempty"`
-	Pronouns string `json:"pronouns,omitempty"`
+	Password Optional[string] `json:"password,omitzero"`
+	Pronouns Optional[string] `json:"pronouns,omitzero"`
 
 	// RevertDisplayName MUST specify currentPassword as well to revert display name
-	RevertDisplayName bool `json:"revertDisplayName,omitempty"`
+	RevertDisplayName Optional[bool] `json:"revertDisplayName,omitzero"`
 
 	// Status Defines the User's current status, for example "ask me", "join me" or "offline. This status is a combined indicator of their online activity and privacy preference.
-	Status            UserStatus `json:"status,omitempty"`
-	StatusDescription string     `json:"statusDescription,omitempty"`
+	Status            Optional[UserStatus] `json:"status,omitzero"`
+	StatusDescription Optional[string]     `json:"statusDescription,omitzero"`
 
 	// Tags
-	Tags        []Tag `json:"tags,omitempty"`
-	Unsubscribe bool  `json:"unsubscribe,omitempty"`
+	Tags        Optional[[]Tag] `json:"tags,omitzero"`
+	Unsubscribe Optional[bool]  `json:"unsubscribe,omitzero"`
 
 	// UserIcon MUST be a valid VRChat /file/ url.
-	UserIcon string `json:"userIcon,omitempty"`
+	UserIcon Optional[string] `json:"userIcon,omitzero"`
 }
 
 type LimitedUserGroups struct {
@@ -2918,8 +2918,8 @@ type ChangeUserTagsRequest struct {
 }
 
 type UpdateUserBadgeRequest struct {
-	Hidden    bool `json:"hidden,omitempty"`
-	Showcased bool `json:"showcased,omitempty"`
+	Hidden    Optional[bool] `json:"hidden,omitzero"`
+	Showcased Optional[bool] `json:"showcased,omitzero"`
 }
 
 type LimitedUnityPackage struct {
@@ -3035,25 +3035,25 @@ type FavoritedWorld struct {
 }
 
 type UpdateWorldRequest struct {
-	AssetUrl     string `json:"assetUrl,omitempty"`
-	AssetVersion string `json:"assetVersion,omitempty"`
+	AssetUrl     Optional[string] `json:"assetUrl,omitzero"`
+	AssetVersion Optional[string] `json:"assetVersion,omitzero"`
 
 	// AuthorId A users unique ID, usually in the form of `usr_c1644b5b-3ca4-45b4-97c6-a2a0de70d469`. Legacy players can have old IDs in the form of `8JoV9XEdpo`. The ID can never be changed.
-	AuthorId    UserId `json:"authorId,omitempty"`
-	AuthorName  string `json:"authorName,omitempty"`
-	Capacity    int64  `json:"capacity,omitempty"`
-	Description string `json:"description,omitempty"`
-	ImageUrl    string `json:"imageUrl,omitempty"`
-	Name        string `json:"name,omitempty"`
+	AuthorId    Optional[UserId] `json:"authorId,omitzero"`
+	AuthorName  Optional[string] `json:"authorName,omitzero"`
+	Capacity    Optional[int64]  `json:"capacity,omitzero"`
+	Description Optional[string] `json:"description,omitzero"`
+	ImageUrl    Optional[string] `json:"imageUrl,omitzero"`
+	Name        Optional[string] `json:"name,omitzero"`
 
 	// Platform This can be `standalonewindows` or `android`, but can also pretty much be any random Unity verison such as `2019.2.4-801-Release` or `2019.2.2-772-Release` or even `unknownplatform`.
-	Platform      Platform      `json:"platform,omitempty"`
-	ReleaseStatus ReleaseStatus `json:"releaseStatus,omitempty"`
+	Platform      Optional[Platform]      `json:"platform,omitzero"`
+	ReleaseStatus Optional[ReleaseStatus] `json:"releaseStatus,omitzero"`
 
 	// Tags
-	Tags            []Tag  `json:"tags,omitempty"`
-	UnityPackageUrl string `json:"unityPackageUrl,omitempty"`
-	UnityVersion    string `json:"unityVersion,omitempty"`
+	Tags            Optional[[]Tag]  `json:"tags,omitzero"`
+	UnityPackageUrl Optional[string] `json:"unityPackageUrl,omitzero"`
+	UnityVersion    Optional[string] `json:"unityVersion,omitzero"`
 }
 
 type WorldMetadata struct {
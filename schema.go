@@ -0,0 +1,91 @@
+package vrchat
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// UnmarshalJSON decodes the time returned by GetSystemTime, the generated type
+// does not inherit the JSON methods of time.Time.
+func (t *SystemTimeResponse) UnmarshalJSON(data []byte) error {
+	return (*time.Time)(t).UnmarshalJSON(data)
+}
+
+// UnmarshalJSON decodes a notification from the REST API, whose details are a string
+// holding encoded JSON, as well as one from the pipeline, whose details are an object.
+// Details holds the encoded JSON either way.
+func (n *Notification) UnmarshalJSON(data []byte) error {
+	type notification Notification
+	var v struct {
+		notification
+		Details json.RawMessage `json:"details"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*n = Notification(v.notification)
+	n.Details = decodeDetails(v.Details)
+	return nil
+}
+
+// decodeDetails returns the JSON encoded in details, which is either a string or the JSON itself.
+func decodeDetails(details []byte) string {
+	var encoded string
+	if err := json.Unmarshal(details, &encoded); err == nil {
+		return encoded
+	}
+	if bytes.Equal(bytes.TrimSpace(details), []byte("null")) {
+		return ""
+	}
+	return string(details)
+}
+
+// Detail decodes the details of the notification according to its Type. It returns a
+// NotificationDetailInvite, NotificationDetailInviteResponse, NotificationDetailRequestInvite,
+// NotificationDetailRequestInviteResponse or NotificationDetailVoteToKick, and nil for
+// types without details, such as friend requests.
+func (n *Notification) Detail() (any, error) {
+	switch n.Type {
+	case NotificationTypeInvite:
+		return decodeDetail[NotificationDetailInvite](n)
+	case NotificationTypeInviteResponse:
+		return decodeDetail[NotificationDetailInviteResponse](n)
+	case NotificationTypeRequestInvite:
+		return decodeDetail[NotificationDetailRequestInvite](n)
+	case NotificationTypeRequestInviteResponse:
+		return decodeDetail[NotificationDetailRequestInviteResponse](n)
+	case NotificationTypeVotetokick:
+		return decodeDetail[NotificationDetailVoteToKick](n)
+	}
+	return nil, nil
+}
+
+// UnmarshalJSON decodes the notification like Notification.UnmarshalJSON.
+func (n *NotificationResponse) UnmarshalJSON(data []byte) error {
+	return (*Notification)(n).UnmarshalJSON(data)
+}
+
+// Detail decodes the details of the notification like Notification.Detail.
+func (n *NotificationResponse) Detail() (any, error) {
+	return (*Notification)(n).Detail()
+}
+
+// decodeDetail decodes the details of n into a T. Details set by hand may still be
+// encoded once more.
+func decodeDetail[T any](n *Notification) (any, error) {
+	var detail T
+	details := n.Details
+	if details == "" {
+		return detail, nil
+	}
+	if strings.HasPrefix(details, `"`) {
+		details = decodeDetails([]byte(details))
+	}
+	if err := json.Unmarshal([]byte(details), &detail); err != nil {
+		return nil, fmt.Errorf("error decoding details of %s notification %s: %w", n.Type, n.Id, err)
+	}
+	return detail, nil
+}
@@ -0,0 +1,228 @@
+package vrchat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Names of the cookies holding a VRChat session.
+const (
+	AuthCookie          = "auth"
+	TwoFactorAuthCookie = "twoFactorAuth"
+)
+
+// Session is the state needed to resume a login without the credentials.
+type Session struct {
+	// Auth is the value of the auth cookie.
+	Auth string `json:"auth"`
+	// TwoFactorAuth is the value of the twoFactorAuth cookie, empty for accounts without 2FA.
+	TwoFactorAuth string `json:"twoFactorAuth,omitempty"`
+}
+
+// SessionStore persists the session of a Client between runs, see WithSessionStore.
+type SessionStore interface {
+	// Load returns the stored session, or nil if there is none.
+	Load(ctx context.Context) (*Session, error)
+	// Save replaces the stored session.
+	Save(ctx context.Context, session *Session) error
+	// Clear removes the stored session.
+	Clear(ctx context.Context) error
+}
+
+// MemorySessionStore keeps the session in memory, which is useful to hand a session
+// to another Client of the same process. It is safe for concurrent use.
+type MemorySessionStore struct {
+	mu      sync.Mutex
+	session *Session
+}
+
+func (s *MemorySessionStore) Load(ctx context.Context) (*Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.session == nil {
+		return nil, nil
+	}
+	session := *s.session
+	return &session, nil
+}
+
+func (s *MemorySessionStore) Save(ctx context.Context, session *Session) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	saved := *session
+	s.session = &saved
+	return nil
+}
+
+func (s *MemorySessionStore) Clear(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.session = nil
+	return nil
+}
+
+// FileSessionStore keeps the session as JSON in the file at Path, readable only by its owner.
+type FileSessionStore struct {
+	Path string
+}
+
+func (s FileSessionStore) Load(ctx context.Context) (*Session, error) {
+	data, err := os.ReadFile(s.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var session Session
+	if err := json.Unmarshal(data, &session); err != nil {
+		return nil, fmt.Errorf("error decoding session %s: %w", s.Path, err)
+	}
+	return &session, nil
+}
+
+func (s FileSessionStore) Save(ctx context.Context, session *Session) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	// os.WriteFile keeps the mode of an existing file, write a new file created with
+	// mode 0600 and rename it over Path instead, which also never leaves half a session
+	f, err := os.CreateTemp(filepath.Dir(s.Path), filepath.Base(s.Path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), s.Path)
+}
+
+func (s FileSessionStore) Clear(ctx context.Context) error {
+	err := os.Remove(s.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// Session returns the current session cookies of c, or nil if it is not logged in.
+func (c *Client) Session() *Session {
+	jar, u := c.cookieJar()
+	if jar == nil {
+		return nil
+	}
+	var session Session
+	for _, cookie := range jar.Cookies(u) {
+		switch cookie.Name {
+		case AuthCookie:
+			session.Auth = cookie.Value
+		case TwoFactorAuthCookie:
+			session.TwoFactorAuth = cookie.Value
+		}
+	}
+	if session.Auth == "" {
+		return nil
+	}
+	return &session
+}
+
+// SetSession replaces the session cookies of c with session, nil removes them.
+// Requests already being sent may still use the previous session.
+func (c *Client) SetSession(session *Session) {
+	jar, u := c.cookieJar()
+	if jar == nil {
+		return
+	}
+	if session == nil {
+		session = &Session{}
+	}
+	jar.SetCookies(u, []*http.Cookie{
+		sessionCookie(AuthCookie, session.Auth),
+		sessionCookie(TwoFactorAuthCookie, session.TwoFactorAuth),
+	})
+}
+
+// ResumeSession restores the session saved in the SessionStore of c and checks that
+// the API still accepts it. It returns nil if there is no store, no saved session, or
+// the saved session was rejected, in which case it is removed from the store.
+func (c *Client) ResumeSession(ctx context.Context) (*CurrentUser, error) {
+	ctx = withoutReauthentication(ctx)
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+	return c.resumeSession(ctx)
+}
+
+func (c *Client) resumeSession(ctx context.Context) (*CurrentUser, error) {
+	if c.sessions == nil {
+		return nil, nil
+	}
+	session, err := c.sessions.Load(ctx)
+	if err != nil || session == nil {
+		return nil, err
+	}
+
+	c.SetSession(session)
+	token, err := c.VerifyAuthTokenWithContext(ctx)
+	if errors.Is(err, ErrUnauthorized) || (err == nil && !token.Ok) {
+		c.SetSession(nil)
+		return nil, c.sessions.Clear(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := c.GetCurrentUserWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.authGeneration.Add(1)
+	return (*CurrentUser)(user), nil
+}
+
+// saveSession stores the current session of c if it has a SessionStore.
+func (c *Client) saveSession(ctx context.Context) error {
+	if c.sessions == nil {
+		return nil
+	}
+	session := c.Session()
+	if session == nil {
+		return nil
+	}
+	if err := c.sessions.Save(ctx, session); err != nil {
+		return fmt.Errorf("error saving session: %w", err)
+	}
+	return nil
+}
+
+// cookieJar returns the cookie jar of c and the URL its session cookies belong to.
+func (c *Client) cookieJar() (http.CookieJar, *url.URL) {
+	jar := c.client.GetClient().Jar
+	u, err := url.Parse(c.client.BaseURL)
+	if jar == nil || err != nil {
+		return nil, nil
+	}
+	u.Path = "/"
+	return jar, u
+}
+
+// sessionCookie returns the cookie setting name to value, or deleting it if value is empty.
+func sessionCookie(name, value string) *http.Cookie {
+	cookie := &http.Cookie{Name: name, Value: value, Path: "/"}
+	if value == "" {
+		cookie.MaxAge = -1
+	}
+	return cookie
+}
@@ -0,0 +1,47 @@
+package vrchat
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFileSessionStore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	s := FileSessionStore{Path: filepath.Join(dir, "session.json")}
+
+	if session, err := s.Load(ctx); session != nil || err != nil {
+		t.Errorf("Load without a file = %+v, %v, want nothing", session, err)
+	}
+	// An existing file readable by others is narrowed to its owner
+	if err := os.WriteFile(s.Path, []byte("{}"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	want := &Session{Auth: testAuthToken, TwoFactorAuth: "twoFactorAuth_c1644b5b"}
+	if err := s.Save(ctx, want); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	info, err := os.Stat(s.Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mode := info.Mode().Perm(); mode != 0o600 {
+		t.Errorf("saved session has mode %v, want 0600", mode)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 1 {
+		t.Errorf("Save left %d files, want only the session", len(entries))
+	}
+	session, err := s.Load(ctx)
+	if err != nil || session == nil || *session != *want {
+		t.Errorf("Load = %+v, %v, want %+v", session, err, want)
+	}
+
+	if err := s.Clear(ctx); err != nil {
+		t.Fatalf("Clear: %v", err)
+	}
+	if err := s.Clear(ctx); err != nil {
+		t.Errorf("Clear without a file: %v", err)
+	}
+}
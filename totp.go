@@ -0,0 +1,82 @@
+package vrchat
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TOTP parameters used by VRChat, the defaults of RFC 6238.
+const (
+	totpPeriod = 30 * time.Second
+	totpDigits = 6
+)
+
+// GenerateTOTP returns the RFC 6238 code of secret at the given time. The secret is
+// the base32 key shown when enabling two-factor authentication, spaces and
+// lowercase letters are accepted.
+func GenerateTOTP(secret string, at time.Time) (string, error) {
+	key, err := decodeTOTPSecret(secret)
+	if err != nil {
+		return "", err
+	}
+
+	var counter [8]byte
+	binary.BigEndian.PutUint64(counter[:], uint64(at.Unix()/int64(totpPeriod/time.Second)))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(counter[:])
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation, RFC 4226 section 5.3
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", totpDigits, value%1_000_000), nil
+}
+
+// ValidateTOTPSecret checks that secret is a base32 TOTP secret GenerateTOTP accepts.
+func ValidateTOTPSecret(secret string) error {
+	_, err := decodeTOTPSecret(secret)
+	return err
+}
+
+func decodeTOTPSecret(secret string) ([]byte, error) {
+	secret = strings.ToUpper(strings.ReplaceAll(secret, " ", ""))
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(strings.TrimRight(secret, "="))
+	if err != nil {
+		return nil, fmt.Errorf("vrchat: invalid TOTP secret: %w", err)
+	}
+	if len(key) == 0 {
+		return nil, errors.New("vrchat: invalid TOTP secret: empty key")
+	}
+	return key, nil
+}
+
+// serverTime estimates the current time of the API, so TOTP codes are generated
+// for the clock the API checks them against rather than the local one.
+func (c *Client) serverTime(ctx context.Context) (time.Time, error) {
+	before := time.Now()
+	server, err := c.GetSystemTimeWithContext(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	after := time.Now()
+
+	// The API truncates to whole seconds, assume the middle of the second and of the round trip
+	offset := time.Time(*server).Add(time.Second / 2).Sub(before.Add(after.Sub(before) / 2))
+	return time.Now().Add(offset), nil
+}
+
+// totpCode returns the current code for the TOTP secret of c, corrected for clock skew.
+func (c *Client) totpCode(ctx context.Context) (string, error) {
+	now, err := c.serverTime(ctx)
+	if err != nil {
+		return "", fmt.Errorf("error measuring clock skew: %w", err)
+	}
+	return GenerateTOTP(c.totpSecret, now)
+}
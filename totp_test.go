@@ -0,0 +1,75 @@
+package vrchat
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// The SHA1 test vectors of RFC 6238, truncated to six digits. The secret is the
+// ASCII string "12345678901234567890".
+func TestGenerateTOTP(t *testing.T) {
+	const secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+	for _, test := range tests {
+		code, err := GenerateTOTP(secret, time.Unix(test.unix, 0))
+		if err != nil {
+			t.Fatalf("GenerateTOTP at %d: %v", test.unix, err)
+		}
+		if code != test.code {
+			t.Errorf("GenerateTOTP at %d = %s, want %s", test.unix, code, test.code)
+		}
+	}
+}
+
+func TestGenerateTOTPInvalidSecret(t *testing.T) {
+	for _, secret := range []string{"", "   ", "====", "not base32!"} {
+		if _, err := GenerateTOTP(secret, time.Unix(59, 0)); err == nil {
+			t.Errorf("GenerateTOTP(%q) succeeded", secret)
+		}
+	}
+}
+
+func TestLoginInvalidTOTPSecret(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "test",
+		WithRateLimiter(nil),
+		WithLogger(discardLogger{}),
+		WithTOTPSecret("  "),
+		WithCredentials(StaticCredentials(Credentials{Username: "bot", Password: "secret"})),
+	)
+	want := ValidateTOTPSecret("  ")
+	if _, err := c.Login("bot", "secret"); err == nil || err.Error() != want.Error() {
+		t.Errorf("Login with an invalid TOTP secret = %v, want %v", err, want)
+	}
+	if n := requests.Load(); n != 0 {
+		t.Errorf("Login with an invalid TOTP secret sent %d requests, want none", n)
+	}
+
+	// A re-login after a 401 fails the same way
+	if _, err := c.GetCurrentUser(); err == nil || !strings.Contains(err.Error(), want.Error()) {
+		t.Errorf("GetCurrentUser with an invalid TOTP secret = %v, want %v", err, want)
+	}
+	if n := requests.Load(); n != 1 {
+		t.Errorf("GetCurrentUser with an invalid TOTP secret sent %d requests, want 1", n)
+	}
+}